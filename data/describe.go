@@ -0,0 +1,128 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Variance returns the variance of the vector elements with ddof delta
+// degrees of freedom: the sum of squared deviations is divided by n - ddof.
+// Use ddof 0 for the population variance and 1 for the unbiased sample
+// variance. Returns an error unless n > ddof.
+func (v *Vector[T]) Variance(ddof int) (float64, error) {
+	n := v.Len()
+	if ddof < 0 || n <= ddof {
+		return 0, errors.New("variance requires more elements than ddof")
+	}
+	mean, _ := v.MeanWith(SumKahan)
+	return v.sumSquaredDeviations(mean) / float64(n-ddof), nil
+}
+
+// StdDevDDOF returns the standard deviation with ddof delta degrees of
+// freedom, the square root of Variance(ddof)
+func (v *Vector[T]) StdDevDDOF(ddof int) (float64, error) {
+	variance, err := v.Variance(ddof)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
+
+// SEM returns the standard error of the mean, the sample standard deviation
+// divided by the square root of n. Returns an error for fewer than two elements.
+func (v *Vector[T]) SEM() (float64, error) {
+	std, err := v.StdDevDDOF(1)
+	if err != nil {
+		return 0, err
+	}
+	return std / math.Sqrt(float64(v.Len())), nil
+}
+
+// Skewness returns the Fisher-Pearson coefficient of skewness m3 / m2^1.5,
+// where mk is the k-th central moment. This is the biased estimator, as
+// computed by scipy.stats.skew with its default bias=True. Returns an error
+// for an empty or constant vector.
+func (v *Vector[T]) Skewness() (float64, error) {
+	m2, m3, _, err := v.centralMoments()
+	if err != nil {
+		return 0, err
+	}
+	return m3 / math.Pow(m2, 1.5), nil
+}
+
+// Kurtosis returns the excess kurtosis m4 / m2^2 - 3, which is zero for a
+// normal distribution, where mk is the k-th central moment. This is the
+// biased estimator, as computed by scipy.stats.kurtosis with its defaults.
+// Returns an error for an empty or constant vector.
+func (v *Vector[T]) Kurtosis() (float64, error) {
+	m2, _, m4, err := v.centralMoments()
+	if err != nil {
+		return 0, err
+	}
+	return m4/(m2*m2) - 3, nil
+}
+
+// centralMoments returns the second, third and fourth central moments
+func (v *Vector[T]) centralMoments() (m2, m3, m4 float64, err error) {
+	n := v.Len()
+	if n == 0 {
+		return 0, 0, 0, errors.New("cannot calculate moments of empty vector")
+	}
+	mean, _ := v.MeanWith(SumKahan)
+	for _, val := range v.All() {
+		d := float64(val) - mean
+		d2 := d * d
+		m2 += d2
+		m3 += d2 * d
+		m4 += d2 * d2
+	}
+	if m2 == 0 {
+		return 0, 0, 0, errors.New("moments undefined for constant vector")
+	}
+	return m2 / float64(n), m3 / float64(n), m4 / float64(n), nil
+}
+
+// Summary holds descriptive statistics of a vector, as returned by Describe
+type Summary struct {
+	Count  int
+	Mean   float64
+	Std    float64 // sample standard deviation (ddof 1), NaN if Count is 1
+	Min    float64
+	Q25    float64
+	Median float64
+	Q75    float64
+	Max    float64
+}
+
+// String formats the summary as a table like pandas' describe
+func (s Summary) String() string {
+	return fmt.Sprintf("count  %d\nmean   %g\nstd    %g\nmin    %g\n25%%    %g\n50%%    %g\n75%%    %g\nmax    %g",
+		s.Count, s.Mean, s.Std, s.Min, s.Q25, s.Median, s.Q75, s.Max)
+}
+
+// Describe returns the count, mean, sample standard deviation, minimum,
+// quartiles and maximum of the vector, like pandas' describe. Quartiles use
+// linear interpolation. Returns an error for an empty vector.
+func (v *Vector[T]) Describe() (Summary, error) {
+	quartiles, err := v.Quantiles(InterpLinear, 0, 0.25, 0.5, 0.75, 1)
+	if err != nil {
+		return Summary{}, err
+	}
+	q := quartiles.Element
+	mean, _ := v.Mean()
+	std, err := v.StdDevDDOF(1)
+	if err != nil {
+		std = math.NaN()
+	}
+	return Summary{
+		Count:  v.Len(),
+		Mean:   mean,
+		Std:    std,
+		Min:    q[0],
+		Q25:    q[1],
+		Median: q[2],
+		Q75:    q[3],
+		Max:    q[4],
+	}, nil
+}
@@ -0,0 +1,104 @@
+package data
+
+import (
+	"math"
+	"testing"
+)
+
+// moments is a sample with known moments: mean 5, population variance 4
+var moments = Vector[int]{Element: []int{2, 4, 4, 4, 5, 5, 7, 9}}
+
+func TestVectorVariance(t *testing.T) {
+	tests := []struct {
+		ddof     int
+		expected float64
+	}{
+		{0, 4},
+		{1, 32.0 / 7},
+		{7, 32},
+	}
+
+	for _, tt := range tests {
+		got, err := moments.Variance(tt.ddof)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if math.Abs(got-tt.expected) > 1e-12 {
+			t.Errorf("Variance(%d) = %v, want %v", tt.ddof, got, tt.expected)
+		}
+	}
+
+	for _, ddof := range []int{-1, 8} {
+		if _, err := moments.Variance(ddof); err == nil {
+			t.Errorf("Variance(%d): expected error", ddof)
+		}
+	}
+}
+
+func TestVectorStdDevDDOFAndSEM(t *testing.T) {
+	if got, _ := moments.StdDevDDOF(0); got != moments.StdDev() {
+		t.Errorf("StdDevDDOF(0) = %v, want StdDev() = %v", got, moments.StdDev())
+	}
+	if got, _ := moments.StdDevDDOF(1); math.Abs(got-math.Sqrt(32.0/7)) > 1e-12 {
+		t.Errorf("StdDevDDOF(1) = %v, want %v", got, math.Sqrt(32.0/7))
+	}
+	if got, _ := moments.SEM(); math.Abs(got-math.Sqrt(4.0/7)) > 1e-12 {
+		t.Errorf("SEM() = %v, want %v", got, math.Sqrt(4.0/7))
+	}
+
+	single := Vector[int]{Element: []int{3}}
+	if _, err := single.SEM(); err == nil {
+		t.Error("SEM() with one element: expected error")
+	}
+}
+
+func TestVectorSkewnessKurtosis(t *testing.T) {
+	skew, err := moments.Skewness()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if math.Abs(skew-0.65625) > 1e-12 {
+		t.Errorf("Skewness() = %v, want 0.65625", skew)
+	}
+
+	kurt, err := moments.Kurtosis()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if math.Abs(kurt+0.21875) > 1e-12 {
+		t.Errorf("Kurtosis() = %v, want -0.21875", kurt)
+	}
+
+	symmetric := Vector[float64]{Element: []float64{-2, -1, 0, 1, 2}}
+	if got, _ := symmetric.Skewness(); got != 0 {
+		t.Errorf("Skewness() of symmetric data = %v, want 0", got)
+	}
+
+	constant := Vector[float64]{Element: []float64{3, 3, 3}}
+	if _, err := constant.Kurtosis(); err == nil {
+		t.Error("Kurtosis() of constant vector: expected error")
+	}
+}
+
+func TestVectorDescribe(t *testing.T) {
+	got, err := moments.Describe()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := Summary{Count: 8, Mean: 5, Std: math.Sqrt(32.0 / 7), Min: 2, Q25: 4, Median: 4.5, Q75: 5.5, Max: 9}
+	if got.Count != want.Count || math.Abs(got.Std-want.Std) > 1e-12 ||
+		got.Mean != want.Mean || got.Min != want.Min || got.Q25 != want.Q25 ||
+		got.Median != want.Median || got.Q75 != want.Q75 || got.Max != want.Max {
+		t.Errorf("Describe() = %+v, want %+v", got, want)
+	}
+
+	single, _ := (&Vector[int]{Element: []int{4}}).Describe()
+	if !math.IsNaN(single.Std) || single.Mean != 4 {
+		t.Errorf("Describe() of one element = %+v, want Std NaN and Mean 4", single)
+	}
+
+	if _, err := (&Vector[int]{}).Describe(); err == nil {
+		t.Error("Describe() on empty vector: expected error")
+	}
+}
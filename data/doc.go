@@ -7,10 +7,45 @@
 //
 // The Vector type provides comprehensive mathematical operations including:
 //   - Basic statistics (sum, mean, min, max, standard deviation)
+//   - Order statistics (median, quantiles, percentiles, IQR)
+//   - Moments (variance with ddof, skewness, kurtosis, SEM) and Describe
+//   - Binning (histograms with fixed or automatic bins, digitize, bincount)
 //   - Vector transformations (normalize, scale, sort, reverse)
+//   - Ordering (argsort, rank, top-k, searchsorted)
+//   - Indexing (take, put, scatter-add, permute) with index vectors
 //   - Sequential operations (cumsum, diff)
 //   - Functional operations (apply, unique)
 //
+// OnlineStats computes the same statistics over a stream of values in
+// constant memory, and accumulators for separate parts of a stream can be
+// combined with Merge.
+//
+// SumWith, MeanWith and SumFunc accept a SumMethod selecting naive,
+// Kahan-Neumaier compensated or pairwise summation. Mean and StdDev always use
+// compensated summation.
+//
+// Max, Min, ArgMax, ArgMin and Mean propagate NaN. The Policy variants
+// (MaxPolicy, MeanPolicy, ...) take a NaNPolicy to skip NaNs or report them as
+// ErrNaN instead, and NanSum, NanMean, NanMax and NanMin skip them.
+//
+// CheckedSum, CheckedScale and CheckedCumsum return an *OverflowError naming
+// the offending index instead of wrapping around, and SumInt64 and SumFloat64
+// aggregate small integer types in a wider accumulator.
+//
+// The InPlace methods (ScaleInPlace, AddInPlace, CumsumInPlace, ...) and
+// DiffInto overwrite existing storage instead of allocating a new vector.
+//
+// Slice returns strided views that share the backing array of the parent
+// vector. Views work with every function that accepts a *Vector; code reading
+// elements directly should use At, Set or All rather than indexing Element.
+//
+// Mask is a bit-packed boolean vector produced by comparisons such as
+// Greater and MaskFunc, combined with And, Or, Xor and Not, and used to select
+// elements with Filter.
+//
+// SparseVector stores only the non-zero elements of a high-dimensional vector
+// as sorted indices and values, and converts to and from the dense Vector.
+//
 // Example:
 //
 //	vec := &data.Vector[int]{Element: []int{1, 2, 3, 4, 5}}
@@ -0,0 +1,58 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSlice is returned when view bounds or step are out of range
+var ErrInvalidSlice = errors.New("invalid slice bounds or step")
+
+// ErrNaN is returned by statistics computed with the NaNError policy when the
+// vector contains NaN
+var ErrNaN = errors.New("vector contains NaN")
+
+// ErrInvalidQuantile is returned when a quantile is outside [0, 1] or a
+// percentile is outside [0, 100]
+var ErrInvalidQuantile = errors.New("quantile must be in [0, 1] and percentile in [0, 100]")
+
+// ErrInvalidEdges is returned when histogram bin edges are not strictly
+// increasing or fewer than two. It is the same value as vector.ErrInvalidEdges.
+var ErrInvalidEdges = errors.New("bin edges must be strictly increasing with at least two edges")
+
+// ErrInvalidBins is returned when a histogram is requested with a
+// non-positive number of bins or over a non-finite range
+var ErrInvalidBins = errors.New("histogram needs a positive number of bins and a finite range")
+
+// ErrInvalidPermutation is returned by Permute when an index repeats
+var ErrInvalidPermutation = errors.New("permutation must contain each index exactly once")
+
+// ErrMismatchedLengths is returned when two vectors must have the same length.
+// It is the same value as vector.ErrMismatchedLengths.
+var ErrMismatchedLengths = errors.New("vectors must have the same length")
+
+// OverflowError is returned by the checked operations when a result does not
+// fit in the element type. Op names the operation and Index is the index of
+// the element at which the overflow occurred.
+type OverflowError struct {
+	Op    string
+	Index int
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("%s overflows at index %d", e.Op, e.Index)
+}
+
+// IndexError is returned by Take, Put, ScatterAdd and Permute when an index
+// vector refers outside the vector. Index is the offending index, Position is
+// where it appears in the index vector and Len is the length of the vector
+// being indexed.
+type IndexError struct {
+	Index    int
+	Position int
+	Len      int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d at position %d out of range for length %d", e.Index, e.Position, e.Len)
+}
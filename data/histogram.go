@@ -0,0 +1,210 @@
+package data
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+// BinRule selects how HistogramAuto chooses the number of bins. The rules
+// match NumPy's histogram_bin_edges estimators of the same name.
+type BinRule int
+
+const (
+	// BinsSturges uses log2(n) + 1 bins, which suits small, roughly normal data
+	BinsSturges BinRule = iota
+	// BinsScott uses a bin width of 3.49 * std * n^(-1/3)
+	BinsScott
+	// BinsFD is the Freedman-Diaconis rule, a bin width of 2 * IQR * n^(-1/3),
+	// which is robust to outliers
+	BinsFD
+	// BinsSqrt uses sqrt(n) bins
+	BinsSqrt
+)
+
+// Histogram holds the result of binning a vector. Bin i covers
+// [Edges[i], Edges[i+1]), except the last bin, which also includes its right
+// edge, so Edges has one more element than Counts.
+type Histogram struct {
+	Counts *Vector[int]
+	Edges  *Vector[float64]
+}
+
+// Density returns the counts normalized so that the histogram integrates to
+// one: each count divided by the total count and its bin width. The result is
+// NaN if no element fell in any bin.
+func (h *Histogram) Density() *Vector[float64] {
+	total := float64(h.Counts.Sum())
+	density := make([]float64, h.Counts.Len())
+	for i, c := range h.Counts.All() {
+		density[i] = float64(c) / total / (h.Edges.At(i+1) - h.Edges.At(i))
+	}
+	return &Vector[float64]{Element: density}
+}
+
+// Histogram counts the elements falling in each bin defined by edges.
+// Elements outside [edges[0], edges[len(edges)-1]] and NaNs are not counted.
+// Returns ErrInvalidEdges unless edges are strictly increasing with at least
+// two edges.
+func (v *Vector[T]) Histogram(edges []float64) (*Histogram, error) {
+	if err := CheckEdges(edges); err != nil {
+		return nil, err
+	}
+	counts := make([]int, len(edges)-1)
+	for _, val := range v.All() {
+		if bin := BinIndex(edges, float64(val)); bin >= 0 {
+			counts[bin]++
+		}
+	}
+	return &Histogram{
+		Counts: &Vector[int]{Element: counts},
+		Edges:  &Vector[float64]{Element: slices.Clone(edges)},
+	}, nil
+}
+
+// HistogramBins counts the elements in bins equal-width bins spanning the
+// minimum to the maximum element. A constant vector is binned over
+// [value-0.5, value+0.5] as NumPy does. Returns ErrInvalidBins if bins is not
+// positive or the vector contains NaN or infinities.
+func (v *Vector[T]) HistogramBins(bins int) (*Histogram, error) {
+	if bins <= 0 {
+		return nil, ErrInvalidBins
+	}
+	lo, hi, err := v.histogramRange()
+	if err != nil {
+		return nil, err
+	}
+	if lo == hi {
+		lo, hi = lo-0.5, hi+0.5
+	}
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = lo + (hi-lo)*float64(i)/float64(bins)
+	}
+	// Avoid losing the maximum to rounding in the last edge
+	edges[bins] = hi
+	return v.Histogram(edges)
+}
+
+// HistogramAuto counts the elements in equal-width bins whose number is
+// chosen by rule. As in NumPy the width is computed from the data itself, so
+// if the rule yields a zero bin width, for example for constant data or an IQR
+// of zero with BinsFD, a single bin is used.
+func (v *Vector[T]) HistogramAuto(rule BinRule) (*Histogram, error) {
+	lo, hi, err := v.histogramRange()
+	if err != nil {
+		return nil, err
+	}
+	n := float64(v.Len())
+
+	var width float64
+	switch rule {
+	case BinsScott:
+		width = math.Cbrt(24*math.Sqrt(math.Pi)/n) * v.StdDev()
+	case BinsFD:
+		iqr, _ := v.IQR(InterpLinear)
+		width = 2 * iqr / math.Cbrt(n)
+	case BinsSqrt:
+		width = (hi - lo) / math.Sqrt(n)
+	default:
+		width = (hi - lo) / (math.Log2(n) + 1)
+	}
+
+	bins := 1
+	if width > 0 {
+		bins = max(1, int(math.Ceil((hi-lo)/width)))
+	}
+	return v.HistogramBins(bins)
+}
+
+// histogramRange returns the minimum and maximum element, which must be finite
+func (v *Vector[T]) histogramRange() (float64, float64, error) {
+	if v.Len() == 0 {
+		return 0, 0, errors.New("cannot calculate histogram of empty vector")
+	}
+	if !v.IsFinite() {
+		return 0, 0, ErrInvalidBins
+	}
+	minVal, _ := v.Min()
+	maxVal, _ := v.Max()
+	return float64(minVal), float64(maxVal), nil
+}
+
+// Digitize returns, for each element x, the index i such that
+// edges[i-1] <= x < edges[i], as NumPy's digitize does: 0 for elements below
+// edges[0] and len(edges) for elements at or above the last edge and NaNs.
+// Returns ErrInvalidEdges unless edges are strictly increasing; a single edge
+// is allowed.
+func (v *Vector[T]) Digitize(edges []float64) (*Vector[int], error) {
+	if len(edges) == 0 {
+		return nil, ErrInvalidEdges
+	}
+	if len(edges) > 1 {
+		if err := CheckEdges(edges); err != nil {
+			return nil, err
+		}
+	}
+	indices := make([]int, v.Len())
+	for i, val := range v.All() {
+		x := float64(val)
+		if x != x {
+			indices[i] = len(edges)
+			continue
+		}
+		// The number of edges less than or equal to x
+		k, found := slices.BinarySearch(edges, x)
+		if found {
+			k++
+		}
+		indices[i] = k
+	}
+	return &Vector[int]{Element: indices}, nil
+}
+
+// Bincount returns how often each value 0, 1, 2, ... occurs in v. The result
+// has max(v)+1 elements, or minLength if that is larger. Returns an error if v
+// contains a negative value.
+func Bincount(v *Vector[int], minLength int) (*Vector[int], error) {
+	size := max(minLength, 0)
+	for _, val := range v.All() {
+		if val < 0 {
+			return nil, errors.New("bincount requires non-negative values")
+		}
+		size = max(size, val+1)
+	}
+	counts := make([]int, size)
+	for _, val := range v.All() {
+		counts[val]++
+	}
+	return &Vector[int]{Element: counts}, nil
+}
+
+// CheckEdges returns ErrInvalidEdges unless edges are strictly increasing
+// with at least two edges. Together with BinIndex it lets other packages bin
+// values exactly as Histogram does.
+func CheckEdges(edges []float64) error {
+	if len(edges) < 2 {
+		return ErrInvalidEdges
+	}
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i] > edges[i-1]) {
+			return ErrInvalidEdges
+		}
+	}
+	return nil
+}
+
+// BinIndex returns the Histogram bin of x, or -1 if x is outside all bins or
+// NaN. Bin i covers [edges[i], edges[i+1]), and the last bin also includes
+// its right edge. edges must have passed CheckEdges.
+func BinIndex(edges []float64, x float64) int {
+	last := len(edges) - 1
+	if !(x >= edges[0] && x <= edges[last]) {
+		return -1
+	}
+	bin, found := slices.BinarySearch(edges, x)
+	if !found {
+		bin--
+	}
+	return min(bin, last-1)
+}
@@ -0,0 +1,157 @@
+package data
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestVectorHistogram(t *testing.T) {
+	v := Vector[float64]{Element: []float64{0, 0.5, 1, 1.5, 2, 3, math.NaN(), -1}}
+
+	h, err := v.Histogram([]float64{0, 1, 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The last bin includes its right edge; 3, -1 and NaN are outside
+	if !reflect.DeepEqual(h.Counts.Element, []int{2, 3}) {
+		t.Errorf("Histogram() counts = %v, want [2 3]", h.Counts.Element)
+	}
+	if !reflect.DeepEqual(h.Edges.Element, []float64{0, 1, 2}) {
+		t.Errorf("Histogram() edges = %v, want [0 1 2]", h.Edges.Element)
+	}
+
+	for _, edges := range [][]float64{nil, {1}, {0, 0}, {2, 1}, {0, math.NaN()}} {
+		if _, err := v.Histogram(edges); err != ErrInvalidEdges {
+			t.Errorf("Histogram(%v) error = %v, want ErrInvalidEdges", edges, err)
+		}
+	}
+}
+
+func TestVectorHistogramBins(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 2, 2, 3, 3, 3, 4, 5}}
+
+	h, err := v.HistogramBins(4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// numpy.histogram([1, 2, 2, 3, 3, 3, 4, 5], bins=4)
+	if !reflect.DeepEqual(h.Counts.Element, []int{1, 2, 3, 2}) {
+		t.Errorf("HistogramBins(4) counts = %v, want [1 2 3 2]", h.Counts.Element)
+	}
+	if !reflect.DeepEqual(h.Edges.Element, []float64{1, 2, 3, 4, 5}) {
+		t.Errorf("HistogramBins(4) edges = %v, want [1 2 3 4 5]", h.Edges.Element)
+	}
+
+	density := h.Density()
+	var area float64
+	for i, d := range density.All() {
+		area += d * (h.Edges.At(i+1) - h.Edges.At(i))
+	}
+	if math.Abs(area-1) > 1e-12 {
+		t.Errorf("Density() integrates to %v, want 1", area)
+	}
+
+	constant := Vector[int]{Element: []int{7, 7}}
+	h, _ = constant.HistogramBins(2)
+	if !reflect.DeepEqual(h.Edges.Element, []float64{6.5, 7, 7.5}) {
+		t.Errorf("HistogramBins() of constant vector edges = %v, want [6.5 7 7.5]", h.Edges.Element)
+	}
+
+	if _, err := v.HistogramBins(0); err != ErrInvalidBins {
+		t.Errorf("HistogramBins(0) error = %v, want ErrInvalidBins", err)
+	}
+	withInf := Vector[float64]{Element: []float64{1, math.Inf(1)}}
+	if _, err := withInf.HistogramBins(3); err != ErrInvalidBins {
+		t.Errorf("HistogramBins() with Inf error = %v, want ErrInvalidBins", err)
+	}
+}
+
+func TestVectorHistogramAuto(t *testing.T) {
+	v := Vector[float64]{Element: make([]float64, 1000)}
+	for i := range v.Element {
+		v.Element[i] = math.Sin(float64(i)) * 10
+	}
+
+	// Bin counts from the NumPy histogram_bin_edges formulas on the same data
+	tests := []struct {
+		rule BinRule
+		bins int
+	}{
+		{BinsSturges, 11},
+		{BinsSqrt, 32},
+		{BinsScott, 9},
+		{BinsFD, 8},
+	}
+
+	for _, tt := range tests {
+		h, err := v.HistogramAuto(tt.rule)
+		if err != nil {
+			t.Fatalf("HistogramAuto(%d) error: %v", tt.rule, err)
+		}
+		if h.Counts.Len() != tt.bins {
+			t.Errorf("HistogramAuto(%d) used %d bins, want %d", tt.rule, h.Counts.Len(), tt.bins)
+		}
+		if h.Counts.Sum() != v.Len() {
+			t.Errorf("HistogramAuto(%d) counted %d elements, want %d", tt.rule, h.Counts.Sum(), v.Len())
+		}
+	}
+
+	// A zero IQR falls back to a single bin
+	spike := Vector[int]{Element: []int{5, 5, 5, 5, 5, 5, 5, 9}}
+	if h, _ := spike.HistogramAuto(BinsFD); h.Counts.Len() != 1 {
+		t.Errorf("HistogramAuto(BinsFD) with zero IQR used %d bins, want 1", h.Counts.Len())
+	}
+
+	// Constant data has a zero peak-to-peak range, so every rule uses one bin
+	// over [value-0.5, value+0.5]
+	constant := Vector[int]{Element: []int{5, 5, 5, 5}}
+	for _, rule := range []BinRule{BinsSturges, BinsScott, BinsFD, BinsSqrt} {
+		h, err := constant.HistogramAuto(rule)
+		if err != nil {
+			t.Fatalf("HistogramAuto(%d) error: %v", rule, err)
+		}
+		if h.Counts.Len() != 1 || h.Counts.At(0) != 4 || h.Edges.At(0) != 4.5 || h.Edges.At(1) != 5.5 {
+			t.Errorf("HistogramAuto(%d) on constant data = %v over %v, want [4] over [4.5 5.5]", rule, h.Counts.Element, h.Edges.Element)
+		}
+	}
+}
+
+func TestVectorDigitize(t *testing.T) {
+	v := Vector[float64]{Element: []float64{-1, 0, 0.5, 1, 2.5, 3, math.NaN()}}
+
+	got, err := v.Digitize([]float64{0, 1, 2, 3})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// numpy.digitize with right=False
+	if want := []int{0, 1, 1, 2, 3, 4, 4}; !reflect.DeepEqual(got.Element, want) {
+		t.Errorf("Digitize() = %v, want %v", got.Element, want)
+	}
+
+	if _, err := v.Digitize([]float64{1, 0}); err != ErrInvalidEdges {
+		t.Errorf("Digitize() error = %v, want ErrInvalidEdges", err)
+	}
+}
+
+func TestBincount(t *testing.T) {
+	v := Vector[int]{Element: []int{0, 1, 1, 3, 1}}
+
+	got, err := Bincount(&v, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Element, []int{1, 3, 0, 1}) {
+		t.Errorf("Bincount() = %v, want [1 3 0 1]", got.Element)
+	}
+
+	got, _ = Bincount(&v, 6)
+	if got.Len() != 6 {
+		t.Errorf("Bincount() with minLength 6 has length %d", got.Len())
+	}
+
+	negative := Vector[int]{Element: []int{1, -1}}
+	if _, err := Bincount(&negative, 0); err == nil {
+		t.Error("Bincount() with negative value: expected error")
+	}
+}
@@ -0,0 +1,90 @@
+package data
+
+// Index vectors are *Vector[int], as returned by Argsort and Nonzero, and may
+// themselves be views. Every index is checked before anything is read or
+// written, so on error the receiver is left unchanged.
+
+// Take returns the elements at the given indices (a gather). Indices may repeat
+// and appear in any order. Returns an *IndexError for an index outside
+// [0, v.Len()).
+func (v *Vector[T]) Take(indices *Vector[int]) (*Vector[T], error) {
+	if err := checkIndices(indices, v.Len()); err != nil {
+		return nil, err
+	}
+	result := make([]T, indices.Len())
+	s := v.step()
+	for i, idx := range indices.All() {
+		result[i] = v.Element[idx*s]
+	}
+	return &Vector[T]{Element: result}, nil
+}
+
+// Put sets v[indices[i]] = values[i]. When an index repeats, the last value
+// wins. Returns ErrMismatchedLengths if indices and values differ in length and
+// an *IndexError for an index outside [0, v.Len()).
+func (v *Vector[T]) Put(indices *Vector[int], values *Vector[T]) error {
+	if indices.Len() != values.Len() {
+		return ErrMismatchedLengths
+	}
+	if err := checkIndices(indices, v.Len()); err != nil {
+		return err
+	}
+	s := v.step()
+	for i, idx := range indices.All() {
+		v.Element[idx*s] = values.At(i)
+	}
+	return nil
+}
+
+// ScatterAdd adds values[i] to v[indices[i]]. Unlike Put, repeated indices
+// accumulate, so ScatterAdd over a zero vector sums values by index.
+// Returns ErrMismatchedLengths if indices and values differ in length and an
+// *IndexError for an index outside [0, v.Len()).
+func (v *Vector[T]) ScatterAdd(indices *Vector[int], values *Vector[T]) error {
+	if indices.Len() != values.Len() {
+		return ErrMismatchedLengths
+	}
+	if err := checkIndices(indices, v.Len()); err != nil {
+		return err
+	}
+	s := v.step()
+	for i, idx := range indices.All() {
+		v.Element[idx*s] += values.At(i)
+	}
+	return nil
+}
+
+// Permute returns a new vector with result[i] = v[perm[i]]. perm must contain
+// each index in [0, v.Len()) exactly once: it returns ErrMismatchedLengths if
+// the lengths differ, an *IndexError for an out-of-range index and
+// ErrInvalidPermutation for a repeated one.
+func (v *Vector[T]) Permute(perm *Vector[int]) (*Vector[T], error) {
+	n := v.Len()
+	if perm.Len() != n {
+		return nil, ErrMismatchedLengths
+	}
+	if err := checkIndices(perm, n); err != nil {
+		return nil, err
+	}
+	seen := NewMask(n)
+	result := make([]T, n)
+	s := v.step()
+	for i, idx := range perm.All() {
+		if seen.At(idx) {
+			return nil, ErrInvalidPermutation
+		}
+		seen.Set(idx, true)
+		result[i] = v.Element[idx*s]
+	}
+	return &Vector[T]{Element: result}, nil
+}
+
+// checkIndices returns an *IndexError for the first index outside [0, n)
+func checkIndices(indices *Vector[int], n int) error {
+	for i, idx := range indices.All() {
+		if idx < 0 || idx >= n {
+			return &IndexError{Index: idx, Position: i, Len: n}
+		}
+	}
+	return nil
+}
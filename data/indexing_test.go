@@ -0,0 +1,113 @@
+package data
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func indices(idx ...int) *Vector[int] {
+	return &Vector[int]{Element: idx}
+}
+
+func TestVectorTake(t *testing.T) {
+	v := Vector[int]{Element: []int{10, 20, 30, 40}}
+
+	got, err := v.Take(indices(3, 0, 0, 2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Element, []int{40, 10, 10, 30}) {
+		t.Errorf("Take() = %v, want [40 10 10 30]", got.Element)
+	}
+
+	// Argsort composes with Take to sort a copy
+	sorted, _ := v.Take(v.Argsort(Descending))
+	if !reflect.DeepEqual(sorted.Element, []int{40, 30, 20, 10}) {
+		t.Errorf("Take(Argsort) = %v, want [40 30 20 10]", sorted.Element)
+	}
+
+	// Strided receiver and strided indices
+	view, _ := v.Slice(1, 4, 2) // [20 40]
+	idxView, _ := indices(1, 9, 0, 9).Slice(0, 4, 2)
+	got, _ = view.Take(idxView)
+	if !reflect.DeepEqual(got.Element, []int{40, 20}) {
+		t.Errorf("Take() on views = %v, want [40 20]", got.Element)
+	}
+}
+
+func TestVectorIndexErrors(t *testing.T) {
+	v := Vector[float64]{Element: []float64{1, 2, 3}}
+
+	var ie *IndexError
+	if _, err := v.Take(indices(0, 3)); !errors.As(err, &ie) || ie.Index != 3 || ie.Position != 1 || ie.Len != 3 {
+		t.Errorf("Take() error = %v, want IndexError for index 3 at position 1", err)
+	}
+	if _, err := v.Take(indices(-1)); !errors.As(err, &ie) || ie.Index != -1 {
+		t.Errorf("Take() error = %v, want IndexError for index -1", err)
+	}
+	if err := v.Put(indices(0, 5), &Vector[float64]{Element: []float64{9, 9}}); !errors.As(err, &ie) {
+		t.Errorf("Put() error = %v, want IndexError", err)
+	}
+	if err := v.ScatterAdd(indices(0, 5), &Vector[float64]{Element: []float64{9, 9}}); !errors.As(err, &ie) {
+		t.Errorf("ScatterAdd() error = %v, want IndexError", err)
+	}
+	if !reflect.DeepEqual(v.Element, []float64{1, 2, 3}) {
+		t.Errorf("Receiver modified by failed call: %v", v.Element)
+	}
+	if err := v.Put(indices(0), &Vector[float64]{Element: []float64{1, 2}}); err != ErrMismatchedLengths {
+		t.Errorf("Put() error = %v, want ErrMismatchedLengths", err)
+	}
+	if got := (&IndexError{Index: 7, Position: 2, Len: 5}).Error(); got != "index 7 at position 2 out of range for length 5" {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestVectorPutAndScatterAdd(t *testing.T) {
+	v := Vector[int]{Element: []int{0, 0, 0, 0}}
+	if err := v.Put(indices(1, 3, 1), &Vector[int]{Element: []int{5, 6, 7}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(v.Element, []int{0, 7, 0, 6}) {
+		t.Errorf("Put() = %v, want [0 7 0 6]", v.Element)
+	}
+
+	counts := Vector[int]{Element: make([]int, 3)}
+	if err := counts.ScatterAdd(indices(2, 0, 2, 2), &Vector[int]{Element: []int{1, 1, 1, 1}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(counts.Element, []int{1, 0, 3}) {
+		t.Errorf("ScatterAdd() = %v, want [1 0 3]", counts.Element)
+	}
+
+	// Writes through a view land in the parent
+	parent := Vector[int]{Element: []int{1, 1, 1, 1, 1, 1}}
+	view, _ := parent.Slice(0, 6, 2)
+	view.ScatterAdd(indices(2, 2), &Vector[int]{Element: []int{3, 4}})
+	if !reflect.DeepEqual(parent.Element, []int{1, 1, 1, 1, 8, 1}) {
+		t.Errorf("ScatterAdd() on view: parent = %v, want [1 1 1 1 8 1]", parent.Element)
+	}
+}
+
+func TestVectorPermute(t *testing.T) {
+	v := Vector[int]{Element: []int{10, 20, 30}}
+
+	got, err := v.Permute(indices(2, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Element, []int{30, 10, 20}) {
+		t.Errorf("Permute() = %v, want [30 10 20]", got.Element)
+	}
+
+	if _, err := v.Permute(indices(0, 0, 1)); err != ErrInvalidPermutation {
+		t.Errorf("Permute() with repeat error = %v, want ErrInvalidPermutation", err)
+	}
+	if _, err := v.Permute(indices(0, 1)); err != ErrMismatchedLengths {
+		t.Errorf("Permute() short error = %v, want ErrMismatchedLengths", err)
+	}
+	var ie *IndexError
+	if _, err := v.Permute(indices(0, 1, 3)); !errors.As(err, &ie) {
+		t.Errorf("Permute() out of range error = %v, want IndexError", err)
+	}
+}
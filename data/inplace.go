@@ -0,0 +1,119 @@
+package data
+
+import "github.com/wendersoon/gomathx/parallel"
+
+// The in-place methods overwrite the receiver instead of allocating a new
+// vector, so they perform no allocations. They work on views too, in which
+// case the shared backing array is modified.
+
+// ScaleInPlace multiplies each element by a scalar in place
+func (v *Vector[T]) ScaleInPlace(scalar T) {
+	if n := v.Len(); parallel.Active(n) {
+		parallel.For(n, func(lo, hi int) { v.scaleRange(scalar, lo, hi) })
+		return
+	}
+	v.scaleRange(scalar, 0, v.Len())
+}
+
+// scaleRange multiplies the elements with index in [lo, hi) by a scalar
+func (v *Vector[T]) scaleRange(scalar T, lo, hi int) {
+	e := v.span(lo, hi)
+	for j, s := 0, v.step(); j < len(e); j += s {
+		e[j] *= scalar
+	}
+}
+
+// ApplyInPlace replaces each element with f(element). Large vectors are
+// processed in parallel when enabled in the parallel package, in which case
+// f must be safe for concurrent use.
+func (v *Vector[T]) ApplyInPlace(f func(T) T) {
+	if n := v.Len(); parallel.Active(n) {
+		parallel.For(n, func(lo, hi int) { v.applyRange(f, lo, hi) })
+		return
+	}
+	v.applyRange(f, 0, v.Len())
+}
+
+// applyRange replaces the elements with index in [lo, hi) with f(element)
+func (v *Vector[T]) applyRange(f func(T) T, lo, hi int) {
+	e := v.span(lo, hi)
+	for j, s := 0, v.step(); j < len(e); j += s {
+		e[j] = f(e[j])
+	}
+}
+
+// CumsumInPlace replaces each element with the cumulative sum up to it
+func (v *Vector[T]) CumsumInPlace() {
+	var sum T
+	for j, s := 0, v.step(); j < len(v.Element); j += s {
+		sum += v.Element[j]
+		v.Element[j] = sum
+	}
+}
+
+// AbsInPlace replaces each element with its absolute value.
+// Unlike Abs the element type is kept; unsigned vectors are left unchanged.
+// Like the other unchecked integer arithmetic, negating the minimum value of
+// a signed type wraps around, so for example int8 -128 stays -128. Abs
+// returns float64 values instead, and CheckedMul(x, -1) detects the overflow.
+func (v *Vector[T]) AbsInPlace() {
+	for j, s := 0, v.step(); j < len(v.Element); j += s {
+		if v.Element[j] < 0 {
+			v.Element[j] = -v.Element[j]
+		}
+	}
+}
+
+// AddInPlace adds o to v element-wise.
+// o may be v itself. Returns ErrMismatchedLengths if the lengths differ.
+func (v *Vector[T]) AddInPlace(o *Vector[T]) error {
+	if v.Len() != o.Len() {
+		return ErrMismatchedLengths
+	}
+	sv, so := v.step(), o.step()
+	for i, n := 0, v.Len(); i < n; i++ {
+		v.Element[i*sv] += o.Element[i*so]
+	}
+	return nil
+}
+
+// SubInPlace subtracts o from v element-wise.
+// o may be v itself. Returns ErrMismatchedLengths if the lengths differ.
+func (v *Vector[T]) SubInPlace(o *Vector[T]) error {
+	if v.Len() != o.Len() {
+		return ErrMismatchedLengths
+	}
+	sv, so := v.step(), o.step()
+	for i, n := 0, v.Len(); i < n; i++ {
+		v.Element[i*sv] -= o.Element[i*so]
+	}
+	return nil
+}
+
+// MulInPlace multiplies v by o element-wise.
+// o may be v itself. Returns ErrMismatchedLengths if the lengths differ.
+func (v *Vector[T]) MulInPlace(o *Vector[T]) error {
+	if v.Len() != o.Len() {
+		return ErrMismatchedLengths
+	}
+	sv, so := v.step(), o.step()
+	for i, n := 0, v.Len(); i < n; i++ {
+		v.Element[i*sv] *= o.Element[i*so]
+	}
+	return nil
+}
+
+// DiffInto stores the differences between consecutive elements of v in dst,
+// which must have length max(v.Len()-1, 0). dst may be a view of the first
+// v.Len()-1 elements of v itself, since each element is read before the
+// position preceding it is overwritten.
+func (v *Vector[T]) DiffInto(dst *Vector[T]) error {
+	n := v.Len()
+	if dst.Len() != max(n-1, 0) {
+		return ErrMismatchedLengths
+	}
+	for i := 1; i < n; i++ {
+		dst.Set(i-1, v.At(i)-v.At(i-1))
+	}
+	return nil
+}
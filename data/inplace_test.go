@@ -0,0 +1,168 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVectorScaleInPlace(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 2, 3, 4}}
+	v.ScaleInPlace(3)
+
+	if !reflect.DeepEqual(v.Element, []int{3, 6, 9, 12}) {
+		t.Errorf("ScaleInPlace(3) = %v, want [3 6 9 12]", v.Element)
+	}
+}
+
+func TestVectorApplyInPlace(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 2, 3, 4}}
+	v.ApplyInPlace(func(x int) int { return x * x })
+
+	if !reflect.DeepEqual(v.Element, []int{1, 4, 9, 16}) {
+		t.Errorf("ApplyInPlace(square) = %v, want [1 4 9 16]", v.Element)
+	}
+}
+
+func TestVectorCumsumInPlace(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Empty vector", []int{}, []int{}},
+		{"Multiple elements", []int{1, 2, 3, 4}, []int{1, 3, 6, 10}},
+		{"With negatives", []int{1, -1, 2, -2}, []int{1, 0, 2, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Vector[int]{Element: tt.input}
+			v.CumsumInPlace()
+			if !reflect.DeepEqual(v.Element, tt.expected) {
+				t.Errorf("CumsumInPlace() = %v, want %v", v.Element, tt.expected)
+			}
+		})
+	}
+}
+
+func TestVectorAbsInPlace(t *testing.T) {
+	v := Vector[int]{Element: []int{-3, -1, 0, 2, -5}}
+	v.AbsInPlace()
+
+	if !reflect.DeepEqual(v.Element, []int{3, 1, 0, 2, 5}) {
+		t.Errorf("AbsInPlace() = %v, want [3 1 0 2 5]", v.Element)
+	}
+
+	u := Vector[uint8]{Element: []uint8{0, 200, 7}}
+	u.AbsInPlace()
+	if !reflect.DeepEqual(u.Element, []uint8{0, 200, 7}) {
+		t.Errorf("AbsInPlace() on unsigned = %v, want unchanged", u.Element)
+	}
+}
+
+func TestVectorArithmeticInPlace(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 2, 3}}
+	o := Vector[int]{Element: []int{10, 20, 30}}
+
+	if err := v.AddInPlace(&o); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(v.Element, []int{11, 22, 33}) {
+		t.Errorf("AddInPlace() = %v, want [11 22 33]", v.Element)
+	}
+
+	if err := v.SubInPlace(&o); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(v.Element, []int{1, 2, 3}) {
+		t.Errorf("SubInPlace() = %v, want [1 2 3]", v.Element)
+	}
+
+	// Aliasing the receiver is allowed
+	if err := v.MulInPlace(&v); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(v.Element, []int{1, 4, 9}) {
+		t.Errorf("MulInPlace(self) = %v, want [1 4 9]", v.Element)
+	}
+
+	short := Vector[int]{Element: []int{1}}
+	if err := v.AddInPlace(&short); err != ErrMismatchedLengths {
+		t.Errorf("AddInPlace() error = %v, want ErrMismatchedLengths", err)
+	}
+}
+
+func TestVectorInPlaceOnView(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 0, 2, 0, 3}}
+	view, _ := v.Slice(0, 5, 2)
+
+	view.ScaleInPlace(10)
+	view.CumsumInPlace()
+
+	if !reflect.DeepEqual(v.Element, []int{10, 0, 30, 0, 60}) {
+		t.Errorf("in-place ops on view = %v, want [10 0 30 0 60]", v.Element)
+	}
+}
+
+func TestVectorDiffInto(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 3, 6, 10}}
+	dst := Vector[int]{Element: make([]int, 3)}
+
+	if err := v.DiffInto(&dst); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(dst.Element, []int{2, 3, 4}) {
+		t.Errorf("DiffInto() = %v, want [2 3 4]", dst.Element)
+	}
+
+	// Writing into the leading elements of the receiver itself
+	prefix, _ := v.Slice(0, 3, 1)
+	if err := v.DiffInto(prefix); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(v.Element, []int{2, 3, 4, 10}) {
+		t.Errorf("DiffInto(prefix of self) = %v, want [2 3 4 10]", v.Element)
+	}
+
+	wrong := Vector[int]{Element: make([]int, 4)}
+	if err := v.DiffInto(&wrong); err != ErrMismatchedLengths {
+		t.Errorf("DiffInto() error = %v, want ErrMismatchedLengths", err)
+	}
+}
+
+func TestVectorInPlaceAllocs(t *testing.T) {
+	v := Vector[float64]{Element: make([]float64, 1000)}
+	o := Vector[float64]{Element: make([]float64, 1000)}
+	dst := Vector[float64]{Element: make([]float64, 999)}
+	double := func(x float64) float64 { return 2 * x }
+
+	allocs := testing.AllocsPerRun(100, func() {
+		v.ScaleInPlace(1.5)
+		v.ApplyInPlace(double)
+		v.CumsumInPlace()
+		v.AbsInPlace()
+		v.AddInPlace(&o)
+		v.DiffInto(&dst)
+	})
+	if allocs != 0 {
+		t.Errorf("in-place methods allocated %v times per run, want 0", allocs)
+	}
+}
+
+func BenchmarkVectorScale(b *testing.B) {
+	v := Vector[float64]{Element: make([]float64, 1000)}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v.Scale(1.0001)
+	}
+}
+
+func BenchmarkVectorScaleInPlace(b *testing.B) {
+	v := Vector[float64]{Element: make([]float64, 1000)}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v.ScaleInPlace(1.0001)
+	}
+}
@@ -0,0 +1,179 @@
+package data
+
+import "math/bits"
+
+// Mask is a fixed-length sequence of booleans stored one bit per element.
+// Masks are produced by comparisons such as Vector.Greater and used to select
+// elements with Vector.Filter. Combining masks of different lengths returns
+// ErrMismatchedLengths.
+type Mask struct {
+	n    int
+	bits []uint64
+}
+
+// NewMask returns a mask of n false values
+func NewMask(n int) *Mask {
+	return &Mask{n: n, bits: make([]uint64, (n+63)/64)}
+}
+
+// MaskFromBools returns a mask with the given values
+func MaskFromBools(values []bool) *Mask {
+	m := NewMask(len(values))
+	for i, val := range values {
+		if val {
+			m.bits[i/64] |= 1 << (i % 64)
+		}
+	}
+	return m
+}
+
+// Len returns the number of values in the mask
+func (m *Mask) Len() int {
+	return m.n
+}
+
+// At returns the value at index i.
+// It panics if i is out of range, like indexing a slice.
+func (m *Mask) At(i int) bool {
+	m.checkIndex(i)
+	return m.bits[i/64]&(1<<(i%64)) != 0
+}
+
+// Set sets the value at index i.
+// It panics if i is out of range, like indexing a slice.
+func (m *Mask) Set(i int, val bool) {
+	m.checkIndex(i)
+	if val {
+		m.bits[i/64] |= 1 << (i % 64)
+	} else {
+		m.bits[i/64] &^= 1 << (i % 64)
+	}
+}
+
+// checkIndex panics if i is out of range; the last word may hold spare bits
+func (m *Mask) checkIndex(i int) {
+	if i < 0 || i >= m.n {
+		panic("data: mask index out of range")
+	}
+}
+
+// Count returns the number of true values
+func (m *Mask) Count() int {
+	count := 0
+	for _, w := range m.bits {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// Any reports whether at least one value is true
+func (m *Mask) Any() bool {
+	for _, w := range m.bits {
+		if w != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// All reports whether every value is true. It is true for an empty mask.
+func (m *Mask) All() bool {
+	return m.Count() == m.n
+}
+
+// Nonzero returns the indices of the true values in ascending order
+func (m *Mask) Nonzero() *Vector[int] {
+	indices := make([]int, 0, m.Count())
+	for k, w := range m.bits {
+		for ; w != 0; w &= w - 1 {
+			indices = append(indices, k*64+bits.TrailingZeros64(w))
+		}
+	}
+	return &Vector[int]{Element: indices}
+}
+
+// Not returns the element-wise negation of the mask
+func (m *Mask) Not() *Mask {
+	result := NewMask(m.n)
+	for k, w := range m.bits {
+		result.bits[k] = ^w
+	}
+	result.clearSpare()
+	return result
+}
+
+// And returns the element-wise conjunction of m and o
+func (m *Mask) And(o *Mask) (*Mask, error) {
+	return m.combine(o, func(a, b uint64) uint64 { return a & b })
+}
+
+// Or returns the element-wise disjunction of m and o
+func (m *Mask) Or(o *Mask) (*Mask, error) {
+	return m.combine(o, func(a, b uint64) uint64 { return a | b })
+}
+
+// Xor returns the element-wise exclusive or of m and o
+func (m *Mask) Xor(o *Mask) (*Mask, error) {
+	return m.combine(o, func(a, b uint64) uint64 { return a ^ b })
+}
+
+// combine applies op word by word to m and o
+func (m *Mask) combine(o *Mask, op func(a, b uint64) uint64) (*Mask, error) {
+	if m.n != o.n {
+		return nil, ErrMismatchedLengths
+	}
+	result := NewMask(m.n)
+	for k := range result.bits {
+		result.bits[k] = op(m.bits[k], o.bits[k])
+	}
+	return result, nil
+}
+
+// clearSpare zeroes the unused bits of the last word, which Count relies on
+func (m *Mask) clearSpare() {
+	if r := m.n % 64; r != 0 {
+		m.bits[len(m.bits)-1] &= 1<<r - 1
+	}
+}
+
+// MaskFunc returns a mask that is true where pred holds for the element
+func (v *Vector[T]) MaskFunc(pred func(T) bool) *Mask {
+	m := NewMask(v.Len())
+	for i, val := range v.All() {
+		if pred(val) {
+			m.bits[i/64] |= 1 << (i % 64)
+		}
+	}
+	return m
+}
+
+// Greater returns a mask that is true where the element is greater than x
+func (v *Vector[T]) Greater(x T) *Mask {
+	return v.MaskFunc(func(val T) bool { return val > x })
+}
+
+// Less returns a mask that is true where the element is less than x
+func (v *Vector[T]) Less(x T) *Mask {
+	return v.MaskFunc(func(val T) bool { return val < x })
+}
+
+// Equal returns a mask that is true where the element equals x.
+// NaN elements never compare equal.
+func (v *Vector[T]) Equal(x T) *Mask {
+	return v.MaskFunc(func(val T) bool { return val == x })
+}
+
+// Filter returns a new vector with the elements where the mask is true.
+// Returns ErrMismatchedLengths if the mask and the vector differ in length.
+func (v *Vector[T]) Filter(m *Mask) (*Vector[T], error) {
+	if m.Len() != v.Len() {
+		return nil, ErrMismatchedLengths
+	}
+	result := make([]T, 0, m.Count())
+	for k, w := range m.bits {
+		for ; w != 0; w &= w - 1 {
+			result = append(result, v.At(k*64+bits.TrailingZeros64(w)))
+		}
+	}
+	return &Vector[T]{Element: result}, nil
+}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestMaskBasics(t *testing.T) {
+	m := NewMask(130)
+	m.Set(0, true)
+	m.Set(64, true)
+	m.Set(129, true)
+	m.Set(64, false)
+
+	if m.Len() != 130 || m.Count() != 2 {
+		t.Errorf("Len() = %d, Count() = %d, want 130 and 2", m.Len(), m.Count())
+	}
+	if !m.At(129) || m.At(64) || m.At(1) {
+		t.Error("At() returned wrong values")
+	}
+	if got := m.Nonzero(); !reflect.DeepEqual(got.Element, []int{0, 129}) {
+		t.Errorf("Nonzero() = %v, want [0 129]", got.Element)
+	}
+	if !m.Any() || m.All() {
+		t.Errorf("Any() = %v, All() = %v, want true and false", m.Any(), m.All())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("At(130) did not panic")
+		}
+	}()
+	m.At(130)
+}
+
+func TestMaskCombinators(t *testing.T) {
+	a := MaskFromBools([]bool{true, true, false, false})
+	b := MaskFromBools([]bool{true, false, true, false})
+
+	tests := []struct {
+		name     string
+		op       func(*Mask) (*Mask, error)
+		expected []int
+	}{
+		{"And", a.And, []int{0}},
+		{"Or", a.Or, []int{0, 1, 2}},
+		{"Xor", a.Xor, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op(b)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got.Nonzero().Element, tt.expected) {
+				t.Errorf("%s() true at %v, want %v", tt.name, got.Nonzero().Element, tt.expected)
+			}
+		})
+	}
+
+	if _, err := a.And(NewMask(3)); err != ErrMismatchedLengths {
+		t.Errorf("And() error = %v, want ErrMismatchedLengths", err)
+	}
+}
+
+func TestMaskNotKeepsLength(t *testing.T) {
+	// Spare bits in the last word must stay clear
+	m := NewMask(70).Not()
+	if m.Count() != 70 || !m.All() {
+		t.Errorf("Not() of empty mask: Count() = %d, want 70", m.Count())
+	}
+	if NewMask(0).Not().Any() || !NewMask(0).All() {
+		t.Error("empty mask: Any() should be false and All() true")
+	}
+}
+
+func TestVectorComparisonsAndFilter(t *testing.T) {
+	v := Vector[float64]{Element: []float64{1, 5, math.NaN(), 3, 5}}
+
+	if got := v.Greater(2).Nonzero(); !reflect.DeepEqual(got.Element, []int{1, 3, 4}) {
+		t.Errorf("Greater(2) true at %v, want [1 3 4]", got.Element)
+	}
+	if got := v.Less(3).Nonzero(); !reflect.DeepEqual(got.Element, []int{0}) {
+		t.Errorf("Less(3) true at %v, want [0]", got.Element)
+	}
+	if got := v.Equal(5).Count(); got != 2 {
+		t.Errorf("Equal(5).Count() = %d, want 2", got)
+	}
+
+	notNaN := v.MaskFunc(func(x float64) bool { return !math.IsNaN(x) })
+	filtered, err := v.Filter(notNaN)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(filtered.Element, []float64{1, 5, 3, 5}) {
+		t.Errorf("Filter() = %v, want [1 5 3 5]", filtered.Element)
+	}
+
+	if _, err := v.Filter(NewMask(2)); err != ErrMismatchedLengths {
+		t.Errorf("Filter() error = %v, want ErrMismatchedLengths", err)
+	}
+}
+
+func BenchmarkVectorFilter(b *testing.B) {
+	v := Vector[float64]{Element: make([]float64, 10000)}
+	for i := range v.Element {
+		v.Element[i] = float64(i % 10)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.Filter(v.Greater(4))
+	}
+}
@@ -0,0 +1,165 @@
+package data
+
+// NaNPolicy selects how statistics treat NaN elements of float vectors.
+// Integer vectors never contain NaN, so the policy has no effect on them.
+type NaNPolicy int
+
+const (
+	// NaNPropagate makes any NaN element produce a NaN result, which is
+	// what the methods without a policy argument do
+	NaNPropagate NaNPolicy = iota
+	// NaNSkip ignores NaN elements as if they were not in the vector
+	NaNSkip
+	// NaNError returns ErrNaN if the vector contains NaN
+	NaNError
+)
+
+// CountNaN returns the number of NaN elements
+func (v *Vector[T]) CountNaN() int {
+	if !isFloat[T]() {
+		return 0
+	}
+	count := 0
+	for _, val := range v.All() {
+		if val != val {
+			count++
+		}
+	}
+	return count
+}
+
+// IsFinite reports whether no element is NaN or infinite
+func (v *Vector[T]) IsFinite() bool {
+	if !isFloat[T]() {
+		return true
+	}
+	for _, val := range v.All() {
+		if !isFinite(val) {
+			return false
+		}
+	}
+	return true
+}
+
+// withPolicy returns the vector a statistic should be computed on under
+// policy p: v itself, a copy without the NaN elements, or ErrNaN
+func (v *Vector[T]) withPolicy(p NaNPolicy) (*Vector[T], error) {
+	if p == NaNPropagate || v.CountNaN() == 0 {
+		return v, nil
+	}
+	if p == NaNError {
+		return nil, ErrNaN
+	}
+	kept := make([]T, 0, v.Len())
+	for _, val := range v.All() {
+		if val == val {
+			kept = append(kept, val)
+		}
+	}
+	return &Vector[T]{Element: kept}, nil
+}
+
+// SumPolicy returns the sum of the elements under NaN policy p
+func (v *Vector[T]) SumPolicy(p NaNPolicy) (T, error) {
+	w, err := v.withPolicy(p)
+	if err != nil {
+		return 0, err
+	}
+	return w.Sum(), nil
+}
+
+// MeanPolicy returns the average value of the vector under NaN policy p.
+// With NaNSkip, a vector holding only NaNs is treated as empty.
+func (v *Vector[T]) MeanPolicy(p NaNPolicy) (float64, error) {
+	w, err := v.withPolicy(p)
+	if err != nil {
+		return 0, err
+	}
+	return w.Mean()
+}
+
+// StdDevPolicy returns the standard deviation of the vector under NaN policy p
+func (v *Vector[T]) StdDevPolicy(p NaNPolicy) (float64, error) {
+	w, err := v.withPolicy(p)
+	if err != nil {
+		return 0, err
+	}
+	return w.StdDev(), nil
+}
+
+// MaxPolicy returns the maximum value in the vector under NaN policy p
+func (v *Vector[T]) MaxPolicy(p NaNPolicy) (T, error) {
+	w, err := v.withPolicy(p)
+	if err != nil {
+		return 0, err
+	}
+	return w.Max()
+}
+
+// MinPolicy returns the minimum value in the vector under NaN policy p
+func (v *Vector[T]) MinPolicy(p NaNPolicy) (T, error) {
+	w, err := v.withPolicy(p)
+	if err != nil {
+		return 0, err
+	}
+	return w.Min()
+}
+
+// ArgMaxPolicy returns the index of the maximum value under NaN policy p.
+// Indices refer to v even when NaNs are skipped; -1 is returned if there is
+// no candidate element.
+func (v *Vector[T]) ArgMaxPolicy(p NaNPolicy) (int, error) {
+	return v.argExtreme(p, func(a, b T) bool { return a > b })
+}
+
+// ArgMinPolicy returns the index of the minimum value under NaN policy p.
+// Indices refer to v even when NaNs are skipped; -1 is returned if there is
+// no candidate element.
+func (v *Vector[T]) ArgMinPolicy(p NaNPolicy) (int, error) {
+	return v.argExtreme(p, func(a, b T) bool { return a < b })
+}
+
+// argExtreme returns the index of the element for which better holds against
+// every other element, treating NaNs according to p
+func (v *Vector[T]) argExtreme(p NaNPolicy, better func(a, b T) bool) (int, error) {
+	best := -1
+	for i, val := range v.All() {
+		if val != val {
+			switch p {
+			case NaNPropagate:
+				return i, nil
+			case NaNError:
+				return -1, ErrNaN
+			}
+			continue
+		}
+		if best < 0 || better(val, v.At(best)) {
+			best = i
+		}
+	}
+	return best, nil
+}
+
+// NanSum returns the sum of the non-NaN elements, which is zero if there are none
+func (v *Vector[T]) NanSum() T {
+	sum, _ := v.SumPolicy(NaNSkip)
+	return sum
+}
+
+// NanMean returns the average of the non-NaN elements.
+// Returns an error if there are none.
+func (v *Vector[T]) NanMean() (float64, error) {
+	return v.MeanPolicy(NaNSkip)
+}
+
+// NanMax returns the maximum of the non-NaN elements.
+// Returns an error if there are none.
+func (v *Vector[T]) NanMax() (T, error) {
+	return v.MaxPolicy(NaNSkip)
+}
+
+// NanMin returns the minimum of the non-NaN elements.
+// Returns an error if there are none.
+func (v *Vector[T]) NanMin() (T, error) {
+	return v.MinPolicy(NaNSkip)
+}
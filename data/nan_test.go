@@ -0,0 +1,162 @@
+package data
+
+import (
+	"math"
+	"testing"
+)
+
+var nan = math.NaN()
+
+func TestVectorMaxMinPropagateNaN(t *testing.T) {
+	// The result must not depend on where the NaN is
+	inputs := [][]float64{
+		{nan, 1, 5, 3},
+		{1, 5, nan, 3},
+		{1, 5, 3, nan},
+	}
+
+	for _, input := range inputs {
+		v := Vector[float64]{Element: input}
+		if got, _ := v.Max(); !math.IsNaN(got) {
+			t.Errorf("Max(%v) = %v, want NaN", input, got)
+		}
+		if got, _ := v.Min(); !math.IsNaN(got) {
+			t.Errorf("Min(%v) = %v, want NaN", input, got)
+		}
+		if got := v.ArgMax(); !math.IsNaN(input[got]) {
+			t.Errorf("ArgMax(%v) = %d, want the NaN index", input, got)
+		}
+		if got := v.ArgMin(); !math.IsNaN(input[got]) {
+			t.Errorf("ArgMin(%v) = %d, want the NaN index", input, got)
+		}
+		if got, _ := v.Mean(); !math.IsNaN(got) {
+			t.Errorf("Mean(%v) = %v, want NaN", input, got)
+		}
+	}
+}
+
+func TestVectorNaNPolicy(t *testing.T) {
+	v := Vector[float64]{Element: []float64{2, nan, 8, nan, 5}}
+
+	tests := []struct {
+		name string
+		stat func(p NaNPolicy) (float64, error)
+		want float64
+	}{
+		{"SumPolicy", v.SumPolicy, 15},
+		{"MeanPolicy", v.MeanPolicy, 5},
+		{"StdDevPolicy", v.StdDevPolicy, math.Sqrt(6)},
+		{"MaxPolicy", v.MaxPolicy, 8},
+		{"MinPolicy", v.MinPolicy, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := tt.stat(NaNSkip); err != nil || math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("NaNSkip: got %v, %v, want %v", got, err, tt.want)
+			}
+			if got, err := tt.stat(NaNPropagate); err != nil || !math.IsNaN(got) {
+				t.Errorf("NaNPropagate: got %v, %v, want NaN", got, err)
+			}
+			if _, err := tt.stat(NaNError); err != ErrNaN {
+				t.Errorf("NaNError: error = %v, want ErrNaN", err)
+			}
+		})
+	}
+}
+
+func TestVectorArgPolicy(t *testing.T) {
+	v := Vector[float64]{Element: []float64{nan, 3, 9, nan, 1}}
+
+	if got, _ := v.ArgMaxPolicy(NaNSkip); got != 2 {
+		t.Errorf("ArgMaxPolicy(NaNSkip) = %d, want 2", got)
+	}
+	if got, _ := v.ArgMinPolicy(NaNSkip); got != 4 {
+		t.Errorf("ArgMinPolicy(NaNSkip) = %d, want 4", got)
+	}
+	if got, _ := v.ArgMaxPolicy(NaNPropagate); got != 0 {
+		t.Errorf("ArgMaxPolicy(NaNPropagate) = %d, want 0", got)
+	}
+	if _, err := v.ArgMinPolicy(NaNError); err != ErrNaN {
+		t.Errorf("ArgMinPolicy(NaNError) error = %v, want ErrNaN", err)
+	}
+
+	all := Vector[float64]{Element: []float64{nan, nan}}
+	if got, err := all.ArgMaxPolicy(NaNSkip); got != -1 || err != nil {
+		t.Errorf("ArgMaxPolicy(NaNSkip) on all-NaN = %d, %v, want -1, nil", got, err)
+	}
+}
+
+func TestVectorNanHelpers(t *testing.T) {
+	v := Vector[float64]{Element: []float64{1, nan, 3}}
+
+	if got := v.NanSum(); got != 4 {
+		t.Errorf("NanSum() = %v, want 4", got)
+	}
+	if got, _ := v.NanMean(); got != 2 {
+		t.Errorf("NanMean() = %v, want 2", got)
+	}
+	if got, _ := v.NanMax(); got != 3 {
+		t.Errorf("NanMax() = %v, want 3", got)
+	}
+	if got, _ := v.NanMin(); got != 1 {
+		t.Errorf("NanMin() = %v, want 1", got)
+	}
+
+	all := Vector[float64]{Element: []float64{nan}}
+	if got := all.NanSum(); got != 0 {
+		t.Errorf("NanSum() on all-NaN = %v, want 0", got)
+	}
+	if _, err := all.NanMean(); err == nil {
+		t.Error("NanMean() on all-NaN: expected error")
+	}
+}
+
+func TestVectorCountNaNIsFinite(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []float64
+		count    int
+		isFinite bool
+	}{
+		{"Finite", []float64{1, 2, 3}, 0, true},
+		{"NaN", []float64{nan, 2, nan}, 2, false},
+		{"Inf", []float64{math.Inf(-1), 2}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Vector[float64]{Element: tt.input}
+			if got := v.CountNaN(); got != tt.count {
+				t.Errorf("CountNaN() = %d, want %d", got, tt.count)
+			}
+			if got := v.IsFinite(); got != tt.isFinite {
+				t.Errorf("IsFinite() = %v, want %v", got, tt.isFinite)
+			}
+		})
+	}
+
+	ints := Vector[int]{Element: []int{1, 2}}
+	if ints.CountNaN() != 0 || !ints.IsFinite() {
+		t.Error("integer vectors never contain NaN or Inf")
+	}
+}
+
+func TestVectorUniqueNaN(t *testing.T) {
+	v := Vector[float64]{Element: []float64{1, nan, 2, nan, 1}}
+	got := v.Unique()
+
+	if got.Len() != 3 || got.Element[0] != 1 || !math.IsNaN(got.Element[1]) || got.Element[2] != 2 {
+		t.Errorf("Unique() = %v, want [1 NaN 2]", got.Element)
+	}
+}
+
+func TestVectorMeanInf(t *testing.T) {
+	v := Vector[float64]{Element: []float64{1, math.Inf(1), 2}}
+	if got, _ := v.Mean(); !math.IsInf(got, 1) {
+		t.Errorf("Mean() = %v, want +Inf", got)
+	}
+	if got := v.SumWith(SumKahan); !math.IsInf(got, 1) {
+		t.Errorf("SumWith(SumKahan) = %v, want +Inf", got)
+	}
+}
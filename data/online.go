@@ -0,0 +1,155 @@
+package data
+
+import (
+	"errors"
+	"math"
+)
+
+// OnlineStats accumulates count, mean, central moments, minimum and maximum
+// over a stream of values in constant memory, using Welford's algorithm
+// extended to higher moments by Terriberry and Pébay. The zero value is an
+// empty accumulator ready to use.
+//
+// Accumulators built on separate parts of a stream, for example by separate
+// goroutines, can be combined with Merge. An OnlineStats must not be used
+// concurrently without external synchronization.
+type OnlineStats struct {
+	n          int
+	mean       float64
+	m2, m3, m4 float64 // sums of powers of deviations from the mean
+	min, max   float64
+}
+
+// Push adds a value to the accumulator
+func (s *OnlineStats) Push(x float64) {
+	// Once a NaN is stored, comparisons fail and it stays
+	switch {
+	case s.n == 0 || x != x:
+		s.min, s.max = x, x
+	case x < s.min:
+		s.min = x
+	case x > s.max:
+		s.max = x
+	}
+
+	n1 := float64(s.n)
+	s.n++
+	n := float64(s.n)
+
+	delta := x - s.mean
+	deltaN := delta / n
+	deltaN2 := deltaN * deltaN
+	term := delta * deltaN * n1
+
+	s.mean += deltaN
+	s.m4 += term*deltaN2*(n*n-3*n+3) + 6*deltaN2*s.m2 - 4*deltaN*s.m3
+	s.m3 += term*deltaN*(n-2) - 3*deltaN*s.m2
+	s.m2 += term
+}
+
+// Merge adds all values accumulated by o to s, as if they had been pushed
+// to s directly. o is not modified.
+func (s *OnlineStats) Merge(o *OnlineStats) {
+	if o.n == 0 {
+		return
+	}
+	if s.n == 0 {
+		*s = *o
+		return
+	}
+
+	na, nb := float64(s.n), float64(o.n)
+	n := na + nb
+	delta := o.mean - s.mean
+	delta2 := delta * delta
+
+	m2 := s.m2 + o.m2 + delta2*na*nb/n
+	m3 := s.m3 + o.m3 + delta2*delta*na*nb*(na-nb)/(n*n) +
+		3*delta*(na*o.m2-nb*s.m2)/n
+	m4 := s.m4 + o.m4 + delta2*delta2*na*nb*(na*na-na*nb+nb*nb)/(n*n*n) +
+		6*delta2*(na*na*o.m2+nb*nb*s.m2)/(n*n) + 4*delta*(na*o.m3-nb*s.m3)/n
+
+	s.n += o.n
+	s.mean += delta * nb / n
+	s.m2, s.m3, s.m4 = m2, m3, m4
+	if o.min < s.min || o.min != o.min {
+		s.min = o.min
+	}
+	if o.max > s.max || o.max != o.max {
+		s.max = o.max
+	}
+}
+
+// Count returns the number of values pushed
+func (s *OnlineStats) Count() int {
+	return s.n
+}
+
+// Mean returns the average of the values pushed
+func (s *OnlineStats) Mean() (float64, error) {
+	if s.n == 0 {
+		return 0, errors.New("cannot calculate mean of empty stream")
+	}
+	return s.mean, nil
+}
+
+// Variance returns the variance with ddof delta degrees of freedom, like
+// Vector.Variance. Returns an error unless the count is greater than ddof.
+func (s *OnlineStats) Variance(ddof int) (float64, error) {
+	if ddof < 0 || s.n <= ddof {
+		return 0, errors.New("variance requires more elements than ddof")
+	}
+	return s.m2 / float64(s.n-ddof), nil
+}
+
+// StdDev returns the population standard deviation, like Vector.StdDev.
+// It returns 0 for an empty stream.
+func (s *OnlineStats) StdDev() float64 {
+	if s.n == 0 {
+		return 0
+	}
+	return math.Sqrt(s.m2 / float64(s.n))
+}
+
+// StdDevDDOF returns the standard deviation with ddof delta degrees of freedom
+func (s *OnlineStats) StdDevDDOF(ddof int) (float64, error) {
+	variance, err := s.Variance(ddof)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
+
+// Min returns the smallest value pushed, or NaN if a NaN was pushed
+func (s *OnlineStats) Min() (float64, error) {
+	if s.n == 0 {
+		return 0, errors.New("cannot calculate min of empty stream")
+	}
+	return s.min, nil
+}
+
+// Max returns the largest value pushed, or NaN if a NaN was pushed
+func (s *OnlineStats) Max() (float64, error) {
+	if s.n == 0 {
+		return 0, errors.New("cannot calculate max of empty stream")
+	}
+	return s.max, nil
+}
+
+// Skewness returns the biased skewness estimator, like Vector.Skewness.
+// Returns an error for an empty or constant stream.
+func (s *OnlineStats) Skewness() (float64, error) {
+	if s.n == 0 || s.m2 == 0 {
+		return 0, errors.New("skewness undefined for empty or constant stream")
+	}
+	return math.Sqrt(float64(s.n)) * s.m3 / math.Pow(s.m2, 1.5), nil
+}
+
+// Kurtosis returns the biased excess kurtosis estimator, like Vector.Kurtosis.
+// Returns an error for an empty or constant stream.
+func (s *OnlineStats) Kurtosis() (float64, error) {
+	if s.n == 0 || s.m2 == 0 {
+		return 0, errors.New("kurtosis undefined for empty or constant stream")
+	}
+	return float64(s.n)*s.m4/(s.m2*s.m2) - 3, nil
+}
@@ -0,0 +1,132 @@
+package data
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// closeTo reports whether got and want agree to a relative tolerance of tol
+func closeTo(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol*math.Max(1, math.Abs(want))
+}
+
+func TestOnlineStatsMatchesVector(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	v := Vector[float64]{Element: make([]float64, 10000)}
+	var s OnlineStats
+	for i := range v.Element {
+		v.Element[i] = 1e6 + rng.ExpFloat64()*3
+		s.Push(v.Element[i])
+	}
+
+	mean, _ := s.Mean()
+	wantMean, _ := v.Mean()
+	variance, _ := s.Variance(1)
+	wantVariance, _ := v.Variance(1)
+	skew, _ := s.Skewness()
+	wantSkew, _ := v.Skewness()
+	kurt, _ := s.Kurtosis()
+	wantKurt, _ := v.Kurtosis()
+	min, _ := s.Min()
+	wantMin, _ := v.Min()
+	max, _ := s.Max()
+	wantMax, _ := v.Max()
+
+	tests := []struct {
+		name      string
+		got, want float64
+	}{
+		{"Mean", mean, wantMean},
+		{"Variance", variance, wantVariance},
+		{"StdDev", s.StdDev(), v.StdDev()},
+		{"Skewness", skew, wantSkew},
+		{"Kurtosis", kurt, wantKurt},
+		{"Min", min, wantMin},
+		{"Max", max, wantMax},
+	}
+	for _, tt := range tests {
+		if !closeTo(tt.got, tt.want, 1e-9) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if s.Count() != v.Len() {
+		t.Errorf("Count() = %d, want %d", s.Count(), v.Len())
+	}
+}
+
+func TestOnlineStatsMerge(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	var whole, left, right OnlineStats
+	for i := 0; i < 1000; i++ {
+		x := rng.NormFloat64()*5 + 2
+		whole.Push(x)
+		if i < 300 {
+			left.Push(x)
+		} else {
+			right.Push(x)
+		}
+	}
+
+	var empty OnlineStats
+	left.Merge(&right)
+	left.Merge(&empty)
+
+	pairs := [][2]float64{
+		{left.mean, whole.mean},
+		{left.m2, whole.m2},
+		{left.m3, whole.m3},
+		{left.m4, whole.m4},
+		{left.min, whole.min},
+		{left.max, whole.max},
+	}
+	for i, p := range pairs {
+		if !closeTo(p[0], p[1], 1e-10) {
+			t.Errorf("merged statistic %d = %v, want %v", i, p[0], p[1])
+		}
+	}
+	if left.Count() != 1000 {
+		t.Errorf("Count() = %d, want 1000", left.Count())
+	}
+
+	// Merging into an empty accumulator copies the other one
+	empty.Merge(&whole)
+	if empty != whole {
+		t.Errorf("Merge into empty = %+v, want %+v", empty, whole)
+	}
+}
+
+func TestOnlineStatsEmptyAndNaN(t *testing.T) {
+	var s OnlineStats
+	if _, err := s.Mean(); err == nil {
+		t.Error("Mean() of empty stream: expected error")
+	}
+	if _, err := s.Max(); err == nil {
+		t.Error("Max() of empty stream: expected error")
+	}
+	if s.StdDev() != 0 {
+		t.Errorf("StdDev() of empty stream = %v, want 0", s.StdDev())
+	}
+
+	s.Push(3)
+	s.Push(3)
+	if _, err := s.Skewness(); err == nil {
+		t.Error("Skewness() of constant stream: expected error")
+	}
+
+	s.Push(math.NaN())
+	s.Push(1)
+	if min, _ := s.Min(); !math.IsNaN(min) {
+		t.Errorf("Min() after NaN = %v, want NaN", min)
+	}
+	if mean, _ := s.Mean(); !math.IsNaN(mean) {
+		t.Errorf("Mean() after NaN = %v, want NaN", mean)
+	}
+}
+
+func BenchmarkOnlineStatsPush(b *testing.B) {
+	var s OnlineStats
+	for i := 0; i < b.N; i++ {
+		s.Push(float64(i))
+	}
+}
@@ -0,0 +1,204 @@
+package data
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+	"sort"
+)
+
+// Order is a sort direction
+type Order int
+
+const (
+	// Ascending sorts from the smallest to the largest element
+	Ascending Order = iota
+	// Descending sorts from the largest to the smallest element
+	Descending
+)
+
+// TieMethod selects how Rank assigns ranks to equal elements. The methods
+// match scipy.stats.rankdata.
+type TieMethod int
+
+const (
+	// RankAverage gives tied elements the average of the ranks they span
+	RankAverage TieMethod = iota
+	// RankMin gives tied elements the lowest rank they span
+	RankMin
+	// RankMax gives tied elements the highest rank they span
+	RankMax
+	// RankDense is like RankMin, but the next distinct value gets the next
+	// rank instead of skipping the ranks used by the ties
+	RankDense
+	// RankOrdinal gives every element a distinct rank, in order of position
+	// among tied elements
+	RankOrdinal
+)
+
+// Side selects which end of a run of equal elements SearchSorted returns
+type Side int
+
+const (
+	// SideLeft returns the index of the first element not less than the value
+	SideLeft Side = iota
+	// SideRight returns the index of the first element greater than the value
+	SideRight
+)
+
+// compareOrder compares a and b in the given order. NaNs sort before all
+// other values in ascending order, as with cmp.Compare.
+func compareOrder[T Number](a, b T, order Order) int {
+	if order == Descending {
+		return cmp.Compare(b, a)
+	}
+	return cmp.Compare(a, b)
+}
+
+// Argsort returns the indices that would sort the vector in the given order.
+// The sort is stable, so equal elements keep their relative order.
+func (v *Vector[T]) Argsort(order Order) *Vector[int] {
+	indices := make([]int, v.Len())
+	for i := range indices {
+		indices[i] = i
+	}
+	slices.SortStableFunc(indices, func(i, j int) int { return compareOrder(v.At(i), v.At(j), order) })
+	return &Vector[int]{Element: indices}
+}
+
+// Rank returns the 1-based rank of each element in ascending order, with
+// ties resolved by method
+func (v *Vector[T]) Rank(method TieMethod) *Vector[float64] {
+	order := v.Argsort(Ascending).Element
+	ranks := make([]float64, len(order))
+	dense := 0
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && v.At(order[end]) == v.At(order[start]) {
+			end++
+		}
+		dense++
+		// Positions start..end-1 span the ranks start+1..end
+		for k, i := range order[start:end] {
+			switch method {
+			case RankMin:
+				ranks[i] = float64(start + 1)
+			case RankMax:
+				ranks[i] = float64(end)
+			case RankDense:
+				ranks[i] = float64(dense)
+			case RankOrdinal:
+				ranks[i] = float64(start + k + 1)
+			default:
+				ranks[i] = float64(start+end+1) / 2
+			}
+		}
+		start = end
+	}
+	return &Vector[float64]{Element: ranks}
+}
+
+// SortDesc sorts the vector in descending order
+func (v *Vector[T]) SortDesc() {
+	v.SortFunc(func(a, b T) int { return cmp.Compare(b, a) })
+}
+
+// SortFunc sorts the vector in place with the comparison function compare,
+// which must return a negative number when a < b, zero when they are equal and a
+// positive number when a > b. The sort is stable.
+func (v *Vector[T]) SortFunc(compare func(a, b T) int) {
+	if v.IsContiguous() {
+		slices.SortStableFunc(v.Element, compare)
+		return
+	}
+	sorted := v.Clone()
+	slices.SortStableFunc(sorted.Element, compare)
+	for i, val := range sorted.Element {
+		v.Set(i, val)
+	}
+}
+
+// Sorted returns a sorted copy of the vector, leaving the receiver unchanged
+func (v *Vector[T]) Sorted(order Order) *Vector[T] {
+	sorted := v.Clone()
+	sorted.SortFunc(func(a, b T) int { return compareOrder(a, b, order) })
+	return sorted
+}
+
+// SearchSorted returns the index at which value would be inserted into the
+// vector, which must be sorted in ascending order, to keep it sorted. With
+// SideLeft the index is before any elements equal to value, with SideRight
+// after them.
+func (v *Vector[T]) SearchSorted(value T, side Side) int {
+	if side == SideRight {
+		return sort.Search(v.Len(), func(i int) bool { return v.At(i) > value })
+	}
+	return sort.Search(v.Len(), func(i int) bool { return v.At(i) >= value })
+}
+
+// TopK returns the k largest elements in descending order and their indices.
+// Equal elements are returned in order of position. k is clamped to
+// [0, Len()]. It keeps a heap of k elements, taking O(n log k) time.
+func (v *Vector[T]) TopK(k int) (*Vector[T], *Vector[int]) {
+	return v.selectK(k, Descending)
+}
+
+// BottomK returns the k smallest elements in ascending order and their
+// indices, like TopK
+func (v *Vector[T]) BottomK(k int) (*Vector[T], *Vector[int]) {
+	return v.selectK(k, Ascending)
+}
+
+// selectK returns the first k elements of the vector in the given order
+func (v *Vector[T]) selectK(k int, order Order) (*Vector[T], *Vector[int]) {
+	k = max(0, min(k, v.Len()))
+	h := &kHeap[T]{v: v, order: order, indices: make([]int, 0, k)}
+	for i := range v.Len() {
+		if len(h.indices) < k {
+			heap.Push(h, i)
+		} else if k > 0 && h.before(i, h.indices[0]) {
+			h.indices[0] = i
+			heap.Fix(h, 0)
+		}
+	}
+
+	indices := h.indices
+	slices.SortFunc(indices, func(i, j int) int {
+		if h.before(i, j) {
+			return -1
+		}
+		return 1
+	})
+	values := make([]T, len(indices))
+	for n, i := range indices {
+		values[n] = v.At(i)
+	}
+	return &Vector[T]{Element: values}, &Vector[int]{Element: indices}
+}
+
+// kHeap holds the indices of the best elements seen so far, with the worst
+// of them at the root so it can be replaced
+type kHeap[T Number] struct {
+	v       *Vector[T]
+	order   Order
+	indices []int
+}
+
+// before reports whether element i comes before element j in the heap's
+// order, breaking ties by position
+func (h *kHeap[T]) before(i, j int) bool {
+	if c := compareOrder(h.v.At(i), h.v.At(j), h.order); c != 0 {
+		return c < 0
+	}
+	return i < j
+}
+
+func (h *kHeap[T]) Len() int           { return len(h.indices) }
+func (h *kHeap[T]) Less(a, b int) bool { return h.before(h.indices[b], h.indices[a]) }
+func (h *kHeap[T]) Swap(a, b int)      { h.indices[a], h.indices[b] = h.indices[b], h.indices[a] }
+func (h *kHeap[T]) Push(x any)         { h.indices = append(h.indices, x.(int)) }
+func (h *kHeap[T]) Pop() any {
+	last := h.indices[len(h.indices)-1]
+	h.indices = h.indices[:len(h.indices)-1]
+	return last
+}
@@ -0,0 +1,144 @@
+package data
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestVectorArgsort(t *testing.T) {
+	v := Vector[int]{Element: []int{3, 1, 2, 1, 3}}
+	original := slices.Clone(v.Element)
+
+	if got := v.Argsort(Ascending); !reflect.DeepEqual(got.Element, []int{1, 3, 2, 0, 4}) {
+		t.Errorf("Argsort(Ascending) = %v, want [1 3 2 0 4]", got.Element)
+	}
+	// Stable: equal elements keep their order in both directions
+	if got := v.Argsort(Descending); !reflect.DeepEqual(got.Element, []int{0, 4, 2, 1, 3}) {
+		t.Errorf("Argsort(Descending) = %v, want [0 4 2 1 3]", got.Element)
+	}
+	if !reflect.DeepEqual(v.Element, original) {
+		t.Errorf("Argsort() modified the receiver: %v", v.Element)
+	}
+}
+
+func TestVectorRank(t *testing.T) {
+	// Expected values from scipy.stats.rankdata([0, 2, 3, 2], method=...)
+	v := Vector[int]{Element: []int{0, 2, 3, 2}}
+
+	tests := []struct {
+		method   TieMethod
+		expected []float64
+	}{
+		{RankAverage, []float64{1, 2.5, 4, 2.5}},
+		{RankMin, []float64{1, 2, 4, 2}},
+		{RankMax, []float64{1, 3, 4, 3}},
+		{RankDense, []float64{1, 2, 3, 2}},
+		{RankOrdinal, []float64{1, 2, 4, 3}},
+	}
+
+	for _, tt := range tests {
+		if got := v.Rank(tt.method); !reflect.DeepEqual(got.Element, tt.expected) {
+			t.Errorf("Rank(%d) = %v, want %v", tt.method, got.Element, tt.expected)
+		}
+	}
+}
+
+func TestVectorSortVariants(t *testing.T) {
+	v := Vector[int]{Element: []int{3, 1, 4, 1, 5}}
+
+	sorted := v.Sorted(Descending)
+	if !reflect.DeepEqual(sorted.Element, []int{5, 4, 3, 1, 1}) {
+		t.Errorf("Sorted(Descending) = %v, want [5 4 3 1 1]", sorted.Element)
+	}
+	if !reflect.DeepEqual(v.Element, []int{3, 1, 4, 1, 5}) {
+		t.Errorf("Sorted() modified the receiver: %v", v.Element)
+	}
+
+	v.SortDesc()
+	if !reflect.DeepEqual(v.Element, []int{5, 4, 3, 1, 1}) {
+		t.Errorf("SortDesc() = %v, want [5 4 3 1 1]", v.Element)
+	}
+
+	// Sort by distance from 3 on a strided view
+	w := Vector[int]{Element: []int{1, 0, 3, 0, 6, 0, 2}}
+	view, _ := w.Slice(0, 7, 2)
+	view.SortFunc(func(a, b int) int { return abs(a-3) - abs(b-3) })
+	if !reflect.DeepEqual(w.Element, []int{3, 0, 2, 0, 1, 0, 6}) {
+		t.Errorf("SortFunc() on view = %v, want [3 0 2 0 1 0 6]", w.Element)
+	}
+}
+
+func TestVectorSearchSorted(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 2, 2, 2, 5}}
+
+	tests := []struct {
+		value int
+		side  Side
+		want  int
+	}{
+		{2, SideLeft, 1},
+		{2, SideRight, 4},
+		{0, SideLeft, 0},
+		{6, SideRight, 5},
+		{3, SideLeft, 4},
+	}
+
+	for _, tt := range tests {
+		if got := v.SearchSorted(tt.value, tt.side); got != tt.want {
+			t.Errorf("SearchSorted(%d, %d) = %d, want %d", tt.value, tt.side, got, tt.want)
+		}
+	}
+}
+
+func TestVectorTopK(t *testing.T) {
+	v := Vector[int]{Element: []int{4, 9, 1, 9, 7, 1}}
+
+	values, indices := v.TopK(3)
+	if !reflect.DeepEqual(values.Element, []int{9, 9, 7}) || !reflect.DeepEqual(indices.Element, []int{1, 3, 4}) {
+		t.Errorf("TopK(3) = %v at %v, want [9 9 7] at [1 3 4]", values.Element, indices.Element)
+	}
+
+	values, indices = v.BottomK(2)
+	if !reflect.DeepEqual(values.Element, []int{1, 1}) || !reflect.DeepEqual(indices.Element, []int{2, 5}) {
+		t.Errorf("BottomK(2) = %v at %v, want [1 1] at [2 5]", values.Element, indices.Element)
+	}
+
+	if values, _ := v.TopK(10); values.Len() != 6 {
+		t.Errorf("TopK(10) returned %d elements, want 6", values.Len())
+	}
+	if values, _ := v.TopK(-1); values.Len() != 0 {
+		t.Errorf("TopK(-1) returned %d elements, want 0", values.Len())
+	}
+}
+
+func TestVectorTopKMatchesArgsort(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	for trial := 0; trial < 50; trial++ {
+		v := Vector[int]{Element: make([]int, 1+rng.Intn(100))}
+		for i := range v.Element {
+			v.Element[i] = rng.Intn(20)
+		}
+		k := rng.Intn(v.Len() + 1)
+
+		_, got := v.TopK(k)
+		want := v.Argsort(Descending).Element[:k]
+		if !reflect.DeepEqual(got.Element, want) {
+			t.Fatalf("TopK(%d) of %v = %v, want %v", k, v.Element, got.Element, want)
+		}
+	}
+}
+
+func BenchmarkVectorTopK(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	v := Vector[float64]{Element: make([]float64, 100000)}
+	for i := range v.Element {
+		v.Element[i] = rng.Float64()
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.TopK(10)
+	}
+}
@@ -0,0 +1,135 @@
+package data
+
+import "math"
+
+// The checked operations behave like their unchecked counterparts but return
+// an *OverflowError instead of silently wrapping around. For floating-point
+// types an overflow is a finite computation producing an infinite result.
+// Intermediate results are checked too, so a sum whose running total leaves
+// the range of T fails even if later elements would bring it back.
+
+// CheckedAdd returns a + b and whether the result fits in T
+func CheckedAdd[T Number](a, b T) (T, bool) {
+	r := a + b
+	switch {
+	case isFloat[T]():
+		return r, !(isFinite(a) && isFinite(b)) || isFinite(r)
+	case isSigned[T]():
+		// Overflow flips the sign of the result away from both operands
+		return r, (a < 0) != (b < 0) || (r < 0) == (a < 0)
+	default:
+		return r, r >= a
+	}
+}
+
+// CheckedMul returns a * b and whether the result fits in T
+func CheckedMul[T Number](a, b T) (T, bool) {
+	r := a * b
+	switch {
+	case isFloat[T]():
+		return r, !(isFinite(a) && isFinite(b)) || isFinite(r)
+	case a == 0 || b == 0:
+		return r, true
+	default:
+		// The sign test catches minInt * -1, which wraps to minInt and
+		// survives the division check
+		return r, r/b == a && ((a < 0) != (b < 0)) == (r < 0)
+	}
+}
+
+// CheckedSum returns the sum of the elements, or an *OverflowError
+// identifying the element whose addition overflowed
+func (v *Vector[T]) CheckedSum() (T, error) {
+	var total T
+	for i, val := range v.All() {
+		var ok bool
+		if total, ok = CheckedAdd(total, val); !ok {
+			return 0, &OverflowError{Op: "sum", Index: i}
+		}
+	}
+	return total, nil
+}
+
+// CheckedScale returns a new vector with each element multiplied by scalar,
+// or an *OverflowError identifying the first element whose product overflowed
+func (v *Vector[T]) CheckedScale(scalar T) (*Vector[T], error) {
+	scaled := make([]T, v.Len())
+	for i, val := range v.All() {
+		var ok bool
+		if scaled[i], ok = CheckedMul(val, scalar); !ok {
+			return nil, &OverflowError{Op: "scale", Index: i}
+		}
+	}
+	return &Vector[T]{Element: scaled}, nil
+}
+
+// CheckedCumsum returns the cumulative sum of the elements, or an
+// *OverflowError identifying the first element whose running total overflowed
+func (v *Vector[T]) CheckedCumsum() (*Vector[T], error) {
+	result := make([]T, v.Len())
+	var sum T
+	for i, val := range v.All() {
+		var ok bool
+		if sum, ok = CheckedAdd(sum, val); !ok {
+			return nil, &OverflowError{Op: "cumsum", Index: i}
+		}
+		result[i] = sum
+	}
+	return &Vector[T]{Element: result}, nil
+}
+
+// SumInt64 returns the sum of the elements accumulated in an int64, which
+// cannot overflow for vectors of 8, 16 or 32-bit integers shorter than 2^31
+// elements. Float elements are truncated toward zero. Returns an
+// *OverflowError if an element or the running total does not fit in int64.
+func (v *Vector[T]) SumInt64() (int64, error) {
+	var total int64
+	for i, val := range v.All() {
+		x, ok := toInt64(val)
+		if ok {
+			total, ok = CheckedAdd(total, x)
+		}
+		if !ok {
+			return 0, &OverflowError{Op: "sum", Index: i}
+		}
+	}
+	return total, nil
+}
+
+// SumFloat64 returns the sum of the elements accumulated in a float64 with
+// Kahan-Neumaier summation. It never overflows for integer element types, but
+// integer sums beyond 2^53 are rounded.
+func (v *Vector[T]) SumFloat64() float64 {
+	return sumVector[T, float64](v, SumKahan)
+}
+
+// toInt64 converts x to int64 and reports whether it is representable
+func toInt64[T Number](x T) (int64, bool) {
+	switch {
+	case isFloat[T]():
+		f := float64(x)
+		// The upper bound 2^63 is exact in float64; NaN fails both tests
+		return int64(f), f >= math.MinInt64 && f < -math.MinInt64
+	case isSigned[T]():
+		return int64(x), true
+	default:
+		return int64(x), uint64(x) <= math.MaxInt64
+	}
+}
+
+// isFloat reports whether T is a floating-point type
+func isFloat[T Number]() bool {
+	var one T = 1
+	return one/2 != 0
+}
+
+// isSigned reports whether T can hold negative values
+func isSigned[T Number]() bool {
+	var zero T
+	return zero-1 < 0
+}
+
+// isFinite reports whether x is neither infinite nor NaN; integers always are
+func isFinite[T Number](x T) bool {
+	return x-x == 0
+}
@@ -0,0 +1,140 @@
+package data
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCheckedAddMulExhaustiveInt8(t *testing.T) {
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			sum, mul := a+b, a*b
+			if _, ok := CheckedAdd(int8(a), int8(b)); ok != (sum >= math.MinInt8 && sum <= math.MaxInt8) {
+				t.Fatalf("CheckedAdd(%d, %d) ok = %v", a, b, ok)
+			}
+			if _, ok := CheckedMul(int8(a), int8(b)); ok != (mul >= math.MinInt8 && mul <= math.MaxInt8) {
+				t.Fatalf("CheckedMul(%d, %d) ok = %v", a, b, ok)
+			}
+		}
+	}
+}
+
+func TestCheckedAddMulExhaustiveUint8(t *testing.T) {
+	for a := 0; a <= math.MaxUint8; a++ {
+		for b := 0; b <= math.MaxUint8; b++ {
+			if _, ok := CheckedAdd(uint8(a), uint8(b)); ok != (a+b <= math.MaxUint8) {
+				t.Fatalf("CheckedAdd(%d, %d) ok = %v", a, b, ok)
+			}
+			if _, ok := CheckedMul(uint8(a), uint8(b)); ok != (a*b <= math.MaxUint8) {
+				t.Fatalf("CheckedMul(%d, %d) ok = %v", a, b, ok)
+			}
+		}
+	}
+}
+
+func TestCheckedFloat(t *testing.T) {
+	if _, ok := CheckedMul(1e300, 1e300); ok {
+		t.Error("CheckedMul(1e300, 1e300) ok = true, want overflow")
+	}
+	if _, ok := CheckedAdd(math.MaxFloat64, math.MaxFloat64); ok {
+		t.Error("CheckedAdd(MaxFloat64, MaxFloat64) ok = true, want overflow")
+	}
+	// Infinite operands are not an overflow of the operation
+	if _, ok := CheckedAdd(math.Inf(1), 1); !ok {
+		t.Error("CheckedAdd(+Inf, 1) ok = false, want true")
+	}
+}
+
+func TestVectorCheckedSum(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []int8
+		expected  int8
+		wantIndex int
+	}{
+		{"No overflow", []int8{100, 20, -50}, 70, -1},
+		{"Positive overflow", []int8{100, 20, 10, -50}, 0, 2},
+		{"Negative overflow", []int8{-100, -28, -1}, 0, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Vector[int8]{Element: tt.input}
+			got, err := v.CheckedSum()
+			if tt.wantIndex < 0 {
+				if err != nil || got != tt.expected {
+					t.Errorf("CheckedSum() = %d, %v, want %d, nil", got, err, tt.expected)
+				}
+				return
+			}
+			var overflow *OverflowError
+			if !errors.As(err, &overflow) || overflow.Index != tt.wantIndex || overflow.Op != "sum" {
+				t.Errorf("CheckedSum() error = %v, want overflow at index %d", err, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestVectorCheckedScale(t *testing.T) {
+	v := Vector[uint8]{Element: []uint8{10, 50, 100}}
+
+	got, err := v.CheckedScale(2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Element, []uint8{20, 100, 200}) {
+		t.Errorf("CheckedScale(2) = %v, want [20 100 200]", got.Element)
+	}
+
+	_, err = v.CheckedScale(3)
+	var overflow *OverflowError
+	if !errors.As(err, &overflow) || overflow.Index != 2 {
+		t.Errorf("CheckedScale(3) error = %v, want overflow at index 2", err)
+	}
+}
+
+func TestVectorCheckedCumsum(t *testing.T) {
+	v := Vector[int16]{Element: []int16{30000, 2000, 1000, -5000}}
+
+	_, err := v.CheckedCumsum()
+	var overflow *OverflowError
+	if !errors.As(err, &overflow) || overflow.Index != 2 || overflow.Op != "cumsum" {
+		t.Errorf("CheckedCumsum() error = %v, want cumsum overflow at index 2", err)
+	}
+	if err.Error() != "cumsum overflows at index 2" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+
+	ok := Vector[int16]{Element: []int16{1, 2, 3}}
+	got, err := ok.CheckedCumsum()
+	if err != nil || !reflect.DeepEqual(got.Element, []int16{1, 3, 6}) {
+		t.Errorf("CheckedCumsum() = %v, %v, want [1 3 6], nil", got, err)
+	}
+}
+
+func TestVectorSumInt64(t *testing.T) {
+	v := Vector[int8]{Element: []int8{127, 127, 127, -128}}
+	if got, err := v.SumInt64(); err != nil || got != 253 {
+		t.Errorf("SumInt64() = %d, %v, want 253, nil", got, err)
+	}
+
+	u := Vector[uint64]{Element: []uint64{1, math.MaxUint64}}
+	var overflow *OverflowError
+	if _, err := u.SumInt64(); !errors.As(err, &overflow) || overflow.Index != 1 {
+		t.Errorf("SumInt64() error = %v, want overflow at index 1", err)
+	}
+
+	f := Vector[float64]{Element: []float64{1.9, -0.5, math.NaN()}}
+	if _, err := f.SumInt64(); !errors.As(err, &overflow) || overflow.Index != 2 {
+		t.Errorf("SumInt64() error = %v, want overflow at index 2 for NaN", err)
+	}
+}
+
+func TestVectorSumFloat64(t *testing.T) {
+	v := Vector[uint8]{Element: []uint8{200, 200, 200}}
+	if got := v.SumFloat64(); got != 600 {
+		t.Errorf("SumFloat64() = %v, want 600", got)
+	}
+}
@@ -0,0 +1,99 @@
+package data
+
+import (
+	"math"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/wendersoon/gomathx/parallel"
+)
+
+// parallelVector returns a vector of n float64 values with a wide dynamic range
+func parallelVector(n int) *Vector[float64] {
+	v := &Vector[float64]{Element: make([]float64, n)}
+	for i := range v.Element {
+		v.Element[i] = math.Sin(float64(i)) * math.Pow(10, float64(i%5))
+	}
+	return v
+}
+
+func TestVectorParallelMatchesSerial(t *testing.T) {
+	ints := &Vector[int]{Element: make([]int, 10001)}
+	for i := range ints.Element {
+		ints.Element[i] = i - 5000
+	}
+	view, _ := ints.Slice(1, 10001, 3)
+	double := func(x int) int { return 2 * x }
+
+	wantSum, wantViewSum := ints.Sum(), view.Sum()
+	wantApply := view.Apply(double)
+
+	defer parallel.SetConfig(parallel.SetConfig(parallel.Config{Workers: 4, Threshold: 1, ChunkSize: 64}))
+
+	if got := ints.Sum(); got != wantSum {
+		t.Errorf("parallel Sum() = %d, want %d", got, wantSum)
+	}
+	if got := view.Sum(); got != wantViewSum {
+		t.Errorf("parallel Sum() on view = %d, want %d", got, wantViewSum)
+	}
+	if got := view.Apply(double); !reflect.DeepEqual(got, wantApply) {
+		t.Errorf("parallel Apply() on view differs from serial result")
+	}
+
+	clone := view.Clone()
+	view.ScaleInPlace(3)
+	view.ApplyInPlace(double)
+	for i := range clone.Element {
+		if got, want := view.At(i), clone.Element[i]*6; got != want {
+			t.Fatalf("parallel in-place ops: element %d = %d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestVectorParallelSumDeterministic(t *testing.T) {
+	v := parallelVector(200000)
+
+	prev := parallel.SetConfig(parallel.Config{Workers: 2, Threshold: 1, ChunkSize: 4096})
+	defer parallel.SetConfig(prev)
+	want := v.Sum()
+
+	for _, workers := range []int{3, 7, 16} {
+		parallel.SetConfig(parallel.Config{Workers: workers, Threshold: 1, ChunkSize: 4096})
+		if got := v.Sum(); got != want {
+			t.Errorf("Sum() with %d workers = %v, want %v", workers, got, want)
+		}
+	}
+}
+
+func BenchmarkVectorSumLarge(b *testing.B) {
+	v := parallelVector(1 << 22)
+
+	b.Run("serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			v.Sum()
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		defer parallel.SetConfig(parallel.SetConfig(parallel.Config{Workers: runtime.GOMAXPROCS(0)}))
+		for i := 0; i < b.N; i++ {
+			v.Sum()
+		}
+	})
+}
+
+func BenchmarkVectorApplyLarge(b *testing.B) {
+	v := parallelVector(1 << 22)
+
+	b.Run("serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			v.Apply(math.Sqrt)
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		defer parallel.SetConfig(parallel.SetConfig(parallel.Config{Workers: runtime.GOMAXPROCS(0)}))
+		for i := 0; i < b.N; i++ {
+			v.Apply(math.Sqrt)
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package data
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+// Interpolation selects how a quantile falling between two elements is
+// computed. The methods match the NumPy methods of the same name: with the
+// sorted elements a[0..n-1] and the virtual index h = (n-1)*q,
+type Interpolation int
+
+const (
+	// InterpLinear returns a[i] + (h-i)*(a[i+1]-a[i]) with i = floor(h)
+	InterpLinear Interpolation = iota
+	// InterpLower returns a[floor(h)]
+	InterpLower
+	// InterpHigher returns a[ceil(h)]
+	InterpHigher
+	// InterpNearest returns a[round(h)], rounding halves to even
+	InterpNearest
+	// InterpMidpoint returns (a[floor(h)] + a[ceil(h)]) / 2
+	InterpMidpoint
+)
+
+// selectCutoff is the length below which selectKth sorts instead of partitioning
+const selectCutoff = 16
+
+// Median returns the median of the vector elements, averaging the two middle
+// elements when the length is even. The receiver is not modified.
+func (v *Vector[T]) Median() (float64, error) {
+	return v.Quantile(0.5, InterpLinear)
+}
+
+// Quantile returns the q-th quantile of the vector elements, for q in [0, 1].
+// The result is NaN if the vector contains NaN. The receiver is not modified.
+func (v *Vector[T]) Quantile(q float64, method Interpolation) (float64, error) {
+	qs, err := v.Quantiles(method, q)
+	if err != nil {
+		return 0, err
+	}
+	return qs.Element[0], nil
+}
+
+// Percentile returns the p-th percentile of the vector elements, for p in [0, 100]
+func (v *Vector[T]) Percentile(p float64, method Interpolation) (float64, error) {
+	return v.Quantile(p/100, method)
+}
+
+// IQR returns the interquartile range, the difference between the 75th and
+// 25th percentiles
+func (v *Vector[T]) IQR(method Interpolation) (float64, error) {
+	qs, err := v.Quantiles(method, 0.25, 0.75)
+	if err != nil {
+		return 0, err
+	}
+	return qs.Element[1] - qs.Element[0], nil
+}
+
+// Quantiles returns the quantiles qs of the vector elements, in the order
+// given. It works on a single copy of the elements and uses selection instead
+// of sorting, so it runs in expected linear time for each quantile. Returns
+// ErrInvalidQuantile if any q is outside [0, 1].
+func (v *Vector[T]) Quantiles(method Interpolation, qs ...float64) (*Vector[float64], error) {
+	n := v.Len()
+	if n == 0 {
+		return nil, errors.New("cannot calculate quantile of empty vector")
+	}
+	for _, q := range qs {
+		if !(q >= 0 && q <= 1) {
+			return nil, ErrInvalidQuantile
+		}
+	}
+
+	result := make([]float64, len(qs))
+	if v.CountNaN() > 0 {
+		for i := range result {
+			result[i] = math.NaN()
+		}
+		return &Vector[float64]{Element: result}, nil
+	}
+
+	// Visit the quantiles in ascending order so each selection only has to
+	// search the part of work to the right of the previous one
+	order := make([]int, len(qs))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(i, j int) int { return cmpFloat(qs[i], qs[j]) })
+
+	work := v.Clone().Element
+	start := 0
+	for _, i := range order {
+		h := float64(n-1) * qs[i]
+		lo := int(math.Floor(h))
+		selectKth(work[start:], lo-start)
+		start = lo
+
+		a := float64(work[lo])
+		b := a
+		if hi := int(math.Ceil(h)); hi > lo {
+			b = float64(slices.Min(work[lo+1:]))
+		}
+		result[i] = interpolate(a, b, h, method)
+	}
+	return &Vector[float64]{Element: result}, nil
+}
+
+// interpolate combines the order statistics a = x[floor(h)] and b = x[ceil(h)]
+// for the virtual index h
+func interpolate(a, b, h float64, method Interpolation) float64 {
+	lo := math.Floor(h)
+	switch method {
+	case InterpLower:
+		return a
+	case InterpHigher:
+		if h > lo {
+			return b
+		}
+		return a
+	case InterpNearest:
+		if math.RoundToEven(h) > lo {
+			return b
+		}
+		return a
+	case InterpMidpoint:
+		return (a + b) / 2
+	default:
+		if h == lo {
+			return a
+		}
+		return a + (h-lo)*(b-a)
+	}
+}
+
+// cmpFloat orders float64 values, for sorting quantiles
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// selectKth rearranges a so that a[k] holds the element that would be at
+// index k if a were sorted, every element before it is less than or equal and
+// every element after it is greater than or equal. It is quickselect with a
+// median-of-three pivot and runs in expected linear time.
+func selectKth[T Number](a []T, k int) {
+	lo, hi := 0, len(a)-1
+	for hi-lo >= selectCutoff {
+		mid := lo + (hi-lo)/2
+		if a[mid] < a[lo] {
+			a[mid], a[lo] = a[lo], a[mid]
+		}
+		if a[hi] < a[lo] {
+			a[hi], a[lo] = a[lo], a[hi]
+		}
+		if a[hi] < a[mid] {
+			a[hi], a[mid] = a[mid], a[hi]
+		}
+		pivot := a[mid]
+
+		i, j := lo, hi
+		for i <= j {
+			for a[i] < pivot {
+				i++
+			}
+			for a[j] > pivot {
+				j--
+			}
+			if i <= j {
+				a[i], a[j] = a[j], a[i]
+				i++
+				j--
+			}
+		}
+
+		// Now a[lo..j] <= pivot, a[i..hi] >= pivot and a[j+1..i-1] == pivot
+		switch {
+		case k <= j:
+			hi = j
+		case k >= i:
+			lo = i
+		default:
+			return
+		}
+	}
+	slices.Sort(a[lo : hi+1])
+}
@@ -0,0 +1,155 @@
+package data
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestVectorMedian(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected float64
+	}{
+		{"Single element", []int{7}, 7},
+		{"Odd length", []int{5, 1, 3}, 3},
+		{"Even length", []int{4, 1, 3, 2}, 2.5},
+		{"Duplicates", []int{2, 2, 2, 1, 2}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Vector[int]{Element: tt.input}
+			original := slices.Clone(tt.input)
+
+			got, err := v.Median()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Median() = %v, want %v", got, tt.expected)
+			}
+			if !reflect.DeepEqual(v.Element, original) {
+				t.Errorf("Median() modified the receiver: %v", v.Element)
+			}
+		})
+	}
+
+	if _, err := (&Vector[int]{}).Median(); err == nil {
+		t.Error("Median() on empty vector: expected error")
+	}
+}
+
+func TestVectorQuantileMethods(t *testing.T) {
+	// Expected values from numpy.quantile(a, q, method=...)
+	v := Vector[float64]{Element: []float64{4, 1, 3, 2}}
+
+	tests := []struct {
+		q        float64
+		method   Interpolation
+		expected float64
+	}{
+		{0.4, InterpLinear, 2.2},
+		{0.4, InterpLower, 2},
+		{0.4, InterpHigher, 3},
+		{0.4, InterpNearest, 2},
+		{0.4, InterpMidpoint, 2.5},
+		{0.5, InterpNearest, 3},     // h = 1.5 rounds to even index 2
+		{1.0 / 6, InterpNearest, 1}, // h = 0.5 rounds to even index 0
+		{0, InterpLinear, 1},
+		{1, InterpHigher, 4},
+		{1, InterpMidpoint, 4},
+	}
+
+	for _, tt := range tests {
+		got, err := v.Quantile(tt.q, tt.method)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if math.Abs(got-tt.expected) > 1e-12 {
+			t.Errorf("Quantile(%v, %d) = %v, want %v", tt.q, tt.method, got, tt.expected)
+		}
+	}
+}
+
+func TestVectorQuantilesMatchSorted(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	qs := []float64{0.9, 0.1, 0.5, 0.33, 1, 0, 0.5}
+
+	for trial := 0; trial < 50; trial++ {
+		n := 1 + rng.Intn(300)
+		v := Vector[int]{Element: make([]int, n)}
+		for i := range v.Element {
+			v.Element[i] = rng.Intn(50)
+		}
+		sorted := slices.Clone(v.Element)
+		slices.Sort(sorted)
+
+		for _, method := range []Interpolation{InterpLinear, InterpLower, InterpHigher, InterpNearest, InterpMidpoint} {
+			got, err := v.Quantiles(method, qs...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for i, q := range qs {
+				h := float64(n-1) * q
+				a, b := float64(sorted[int(math.Floor(h))]), float64(sorted[int(math.Ceil(h))])
+				if want := interpolate(a, b, h, method); got.Element[i] != want {
+					t.Fatalf("n=%d method=%d q=%v: got %v, want %v", n, method, q, got.Element[i], want)
+				}
+			}
+		}
+	}
+}
+
+func TestVectorPercentileAndIQR(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 2, 3, 4, 5, 6, 7, 8, 9}}
+
+	if got, _ := v.Percentile(90, InterpLinear); math.Abs(got-8.2) > 1e-12 {
+		t.Errorf("Percentile(90) = %v, want 8.2", got)
+	}
+	if got, _ := v.IQR(InterpLinear); got != 4 {
+		t.Errorf("IQR() = %v, want 4", got)
+	}
+
+	for _, p := range []float64{-1, 101, math.NaN()} {
+		if _, err := v.Percentile(p, InterpLinear); err != ErrInvalidQuantile {
+			t.Errorf("Percentile(%v) error = %v, want ErrInvalidQuantile", p, err)
+		}
+	}
+}
+
+func TestVectorQuantileViewAndNaN(t *testing.T) {
+	v := Vector[float64]{Element: []float64{9, 0, 1, 0, 5, 0}}
+	view, _ := v.Slice(0, 6, 2)
+	if got, _ := view.Median(); got != 5 {
+		t.Errorf("Median() on view = %v, want 5", got)
+	}
+
+	withNaN := Vector[float64]{Element: []float64{1, math.NaN(), 3}}
+	if got, _ := withNaN.Median(); !math.IsNaN(got) {
+		t.Errorf("Median() with NaN = %v, want NaN", got)
+	}
+}
+
+func BenchmarkVectorMedian(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	v := Vector[float64]{Element: make([]float64, 100000)}
+	for i := range v.Element {
+		v.Element[i] = rng.Float64()
+	}
+
+	b.Run("select", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			v.Median()
+		}
+	})
+	b.Run("sort", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sorted := v.Clone()
+			sorted.Sort()
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package data
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// SparseVector represents a generic sparse vector of dimension Dim.
+// Only non-zero entries are stored: Indices is sorted in strictly increasing
+// order and Values[k] is the element at position Indices[k].
+type SparseVector[T Number] struct {
+	Dim     int
+	Indices []int
+	Values  []T
+}
+
+// Len returns the dimension of the sparse vector
+func (s *SparseVector[T]) Len() int {
+	return s.Dim
+}
+
+// Nnz returns the number of stored (non-zero) elements
+func (s *SparseVector[T]) Nnz() int {
+	return len(s.Indices)
+}
+
+// At returns the element at position i, which is zero if it is not stored
+func (s *SparseVector[T]) At(i int) T {
+	k := sort.SearchInts(s.Indices, i)
+	if k < len(s.Indices) && s.Indices[k] == i {
+		return s.Values[k]
+	}
+	var zero T
+	return zero
+}
+
+// Sum returns the sum of the stored elements
+func (s *SparseVector[T]) Sum() T {
+	var total T
+	for _, val := range s.Values {
+		total += val
+	}
+	return total
+}
+
+// Normalize returns a new sparse vector scaled to unit length (Euclidean norm = 1)
+func (s *SparseVector[T]) Normalize() (*SparseVector[float64], error) {
+	var sumSquares float64
+	for _, val := range s.Values {
+		fVal := float64(val)
+		sumSquares += fVal * fVal
+	}
+
+	if sumSquares == 0 {
+		return nil, errors.New("cannot normalize zero vector")
+	}
+
+	norm := math.Sqrt(sumSquares)
+	normalized := make([]float64, len(s.Values))
+	for i, val := range s.Values {
+		normalized[i] = float64(val) / norm
+	}
+	indices := make([]int, len(s.Indices))
+	copy(indices, s.Indices)
+
+	return &SparseVector[float64]{Dim: s.Dim, Indices: indices, Values: normalized}, nil
+}
+
+// Clone returns a copy of the current sparse vector
+func (s *SparseVector[T]) Clone() *SparseVector[T] {
+	indices := make([]int, len(s.Indices))
+	copy(indices, s.Indices)
+	values := make([]T, len(s.Values))
+	copy(values, s.Values)
+	return &SparseVector[T]{Dim: s.Dim, Indices: indices, Values: values}
+}
+
+// ToDense returns a dense vector with the same elements
+func (s *SparseVector[T]) ToDense() *Vector[T] {
+	dense := make([]T, s.Dim)
+	for k, i := range s.Indices {
+		dense[i] = s.Values[k]
+	}
+	return &Vector[T]{Element: dense}
+}
+
+// ToSparse returns a sparse vector holding the non-zero elements of v
+func (v *Vector[T]) ToSparse() *SparseVector[T] {
+	var indices []int
+	var values []T
+	for i, val := range v.All() {
+		if val != 0 {
+			indices = append(indices, i)
+			values = append(values, val)
+		}
+	}
+	return &SparseVector[T]{Dim: v.Len(), Indices: indices, Values: values}
+}
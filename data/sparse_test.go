@@ -0,0 +1,95 @@
+package data
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSparseVectorAt(t *testing.T) {
+	s := SparseVector[int]{Dim: 6, Indices: []int{1, 4}, Values: []int{7, -2}}
+
+	expected := []int{0, 7, 0, 0, -2, 0}
+	for i, want := range expected {
+		if got := s.At(i); got != want {
+			t.Errorf("At(%d) = %v, want %v", i, got, want)
+		}
+	}
+	if s.Len() != 6 || s.Nnz() != 2 {
+		t.Errorf("Len(), Nnz() = %d, %d, want 6, 2", s.Len(), s.Nnz())
+	}
+}
+
+func TestSparseVectorSum(t *testing.T) {
+	s := SparseVector[int]{Dim: 100, Indices: []int{3, 50, 99}, Values: []int{1, 2, 3}}
+	if got := s.Sum(); got != 6 {
+		t.Errorf("Sum() = %v, want 6", got)
+	}
+}
+
+func TestSparseVectorNormalize(t *testing.T) {
+	tests := []struct {
+		name        string
+		sparse      SparseVector[int]
+		expected    []float64
+		expectError bool
+	}{
+		{"Zero vector", SparseVector[int]{Dim: 3}, nil, true},
+		{"Valid vector", SparseVector[int]{Dim: 5, Indices: []int{0, 3}, Values: []int{3, 4}}, []float64{0.6, 0.8}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sparse.Normalize()
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got.Indices, tt.sparse.Indices) {
+				t.Errorf("Normalize() indices = %v, want %v", got.Indices, tt.sparse.Indices)
+			}
+			for i, val := range got.Values {
+				if math.Abs(val-tt.expected[i]) > 1e-10 {
+					t.Errorf("Normalize()[%d] = %v, want %v", i, val, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSparseVectorClone(t *testing.T) {
+	original := SparseVector[int]{Dim: 4, Indices: []int{0, 2}, Values: []int{1, 2}}
+	cloned := original.Clone()
+
+	cloned.Values[0] = 999
+	cloned.Indices[1] = 3
+	if original.Values[0] == 999 || original.Indices[1] == 3 {
+		t.Errorf("Clone() created shallow copy instead of deep copy")
+	}
+}
+
+func TestSparseDenseRoundTrip(t *testing.T) {
+	dense := Vector[int]{Element: []int{0, 5, 0, 0, -3, 0, 1}}
+
+	sparse := dense.ToSparse()
+	if !reflect.DeepEqual(sparse.Indices, []int{1, 4, 6}) {
+		t.Errorf("ToSparse() indices = %v, want [1 4 6]", sparse.Indices)
+	}
+	if !reflect.DeepEqual(sparse.Values, []int{5, -3, 1}) {
+		t.Errorf("ToSparse() values = %v, want [5 -3 1]", sparse.Values)
+	}
+	if sparse.Dim != dense.Len() {
+		t.Errorf("ToSparse() dim = %d, want %d", sparse.Dim, dense.Len())
+	}
+
+	back := sparse.ToDense()
+	if !reflect.DeepEqual(back.Element, dense.Element) {
+		t.Errorf("ToDense() = %v, want %v", back.Element, dense.Element)
+	}
+}
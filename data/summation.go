@@ -0,0 +1,156 @@
+package data
+
+import (
+	"errors"
+
+	"github.com/wendersoon/gomathx/parallel"
+)
+
+// SumMethod selects the algorithm used to accumulate a sum
+type SumMethod int
+
+const (
+	// SumNaive adds the elements one after another. It is the fastest
+	// method, but the rounding error can grow linearly with the length.
+	SumNaive SumMethod = iota
+	// SumKahan uses Kahan-Neumaier compensated summation, which carries the
+	// rounding error of each addition in a second accumulator. The error
+	// bound does not depend on the length, and terms much larger than the
+	// running sum are handled correctly.
+	SumKahan
+	// SumPairwise recursively sums both halves and adds the results. The
+	// error grows only logarithmically with the length at nearly the speed
+	// of SumNaive.
+	SumPairwise
+)
+
+// pairwiseBlock is the length below which pairwise summation adds naively
+const pairwiseBlock = 128
+
+// SumFunc returns the sum of term(i) for i in [0, n) computed with method.
+// Unknown methods fall back to SumNaive. Large sums are split across the
+// worker pool when parallel execution is enabled, so term must be safe for
+// concurrent use.
+func SumFunc[A Number](n int, term func(i int) A, method SumMethod) A {
+	if parallel.Active(n) {
+		return parallel.Reduce(n,
+			func(lo, hi int) A { return sumTerms(lo, hi, term, method) },
+			func(x, y A) A { return x + y })
+	}
+	return sumTerms(0, n, term, method)
+}
+
+// sumTerms returns the sum of term(i) for i in [lo, hi)
+func sumTerms[A Number](lo, hi int, term func(i int) A, method SumMethod) A {
+	switch method {
+	case SumKahan:
+		return sumNeumaier(lo, hi, term)
+	case SumPairwise:
+		return sumPairwise(lo, hi, term)
+	default:
+		var sum A
+		for i := lo; i < hi; i++ {
+			sum += term(i)
+		}
+		return sum
+	}
+}
+
+// neumaierAdd adds x to sum with Kahan-Neumaier compensation, returning the
+// new sum and the rounding error accumulated in c
+func neumaierAdd[A Number](sum, c, x A) (A, A) {
+	t := sum + x
+	if abs(sum) >= abs(x) {
+		c += (sum - t) + x
+	} else {
+		c += (x - t) + sum
+	}
+	return t, c
+}
+
+// neumaierResult returns the compensated sum
+func neumaierResult[A Number](sum, c A) A {
+	if !isFinite(sum) {
+		// Inf or NaN; the compensation would turn Inf into NaN
+		return sum
+	}
+	return sum + c
+}
+
+// sumNeumaier implements Kahan-Neumaier compensated summation
+func sumNeumaier[A Number](lo, hi int, term func(i int) A) A {
+	var sum, c A
+	for i := lo; i < hi; i++ {
+		sum, c = neumaierAdd(sum, c, term(i))
+	}
+	return neumaierResult(sum, c)
+}
+
+// sumPairwise implements pairwise (cascade) summation
+func sumPairwise[A Number](lo, hi int, term func(i int) A) A {
+	if hi-lo <= pairwiseBlock {
+		var sum A
+		for i := lo; i < hi; i++ {
+			sum += term(i)
+		}
+		return sum
+	}
+	mid := lo + (hi-lo)/2
+	return sumPairwise(lo, mid, term) + sumPairwise(mid, hi, term)
+}
+
+// abs returns the absolute value of x; unsigned values are returned unchanged
+func abs[A Number](x A) A {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// SumWith returns the sum of the elements computed with method
+func (v *Vector[T]) SumWith(method SumMethod) T {
+	return sumVector[T, T](v, method)
+}
+
+// MeanWith returns the average value of the vector, accumulating the sum in
+// float64 with method
+func (v *Vector[T]) MeanWith(method SumMethod) (float64, error) {
+	n := v.Len()
+	if n == 0 {
+		return 0, errors.New("cannot calculate mean of empty vector")
+	}
+	return sumVector[T, float64](v, method) / float64(n), nil
+}
+
+// sumVector sums the elements of v converted to A. It works on the backing
+// slice directly, which is several times faster than SumFunc.
+func sumVector[T, A Number](v *Vector[T], method SumMethod) A {
+	if n := v.Len(); parallel.Active(n) {
+		return parallel.Reduce(n,
+			func(lo, hi int) A { return sumStrided[T, A](v.span(lo, hi), v.step(), method) },
+			func(x, y A) A { return x + y })
+	}
+	return sumStrided[T, A](v.Element, v.step(), method)
+}
+
+// sumStrided sums every s-th element of e, converted to A, with method
+func sumStrided[T, A Number](e []T, s int, method SumMethod) A {
+	var sum A
+	switch method {
+	case SumKahan:
+		var c A
+		for j := 0; j < len(e); j += s {
+			sum, c = neumaierAdd(sum, c, A(e[j]))
+		}
+		return neumaierResult(sum, c)
+	case SumPairwise:
+		if n := (len(e) + s - 1) / s; n > pairwiseBlock {
+			mid := n / 2 * s
+			return sumStrided[T, A](e[:mid], s, method) + sumStrided[T, A](e[mid:], s, method)
+		}
+	}
+	for j := 0; j < len(e); j += s {
+		sum += A(e[j])
+	}
+	return sum
+}
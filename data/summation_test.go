@@ -0,0 +1,110 @@
+package data
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSumWithCancellation(t *testing.T) {
+	// Terms far larger than the running sum defeat naive summation
+	v := Vector[float64]{Element: []float64{1, 1e100, 1, -1e100}}
+
+	if got := v.SumWith(SumNaive); got != 0 {
+		t.Errorf("SumWith(SumNaive) = %v, want the naive result 0", got)
+	}
+	if got := v.SumWith(SumKahan); got != 2 {
+		t.Errorf("SumWith(SumKahan) = %v, want 2", got)
+	}
+}
+
+func TestSumWithDrift(t *testing.T) {
+	const n = 1_000_000
+	v := Vector[float32]{Element: make([]float32, n)}
+	for i := range v.Element {
+		v.Element[i] = 0.1
+	}
+	exact := float64(float32(0.1)) * n
+
+	naiveErr := math.Abs(float64(v.SumWith(SumNaive)) - exact)
+	if naiveErr < 100 {
+		t.Fatalf("naive float32 error = %v, expected visible drift", naiveErr)
+	}
+
+	// Both accurate methods must cut the error by at least two orders of magnitude
+	for _, method := range []SumMethod{SumKahan, SumPairwise} {
+		got := float64(v.SumWith(method))
+		if err := math.Abs(got - exact); err > naiveErr/100 {
+			t.Errorf("SumWith(%d) = %v, error %v is not much below the naive error %v", method, got, err, naiveErr)
+		}
+	}
+}
+
+func TestSumWithIntegersAndViews(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 2, 3, 4, 5, 6, 7}}
+	view, _ := v.Slice(0, 7, 2)
+
+	for _, method := range []SumMethod{SumNaive, SumKahan, SumPairwise} {
+		if got := v.SumWith(method); got != 28 {
+			t.Errorf("SumWith(%d) = %d, want 28", method, got)
+		}
+		if got := view.SumWith(method); got != 16 {
+			t.Errorf("SumWith(%d) on view = %d, want 16", method, got)
+		}
+	}
+}
+
+func TestSumPairwiseLong(t *testing.T) {
+	// Long enough to recurse several levels below pairwiseBlock
+	got := SumFunc(1000, func(i int) int { return i }, SumPairwise)
+	if got != 499500 {
+		t.Errorf("SumFunc(SumPairwise) = %d, want 499500", got)
+	}
+}
+
+func TestMeanCompensated(t *testing.T) {
+	v := Vector[float64]{Element: []float64{1e100, 1, -1e100, 1}}
+
+	got, err := v.Mean()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != 0.5 {
+		t.Errorf("Mean() = %v, want 0.5", got)
+	}
+
+	if _, err := (&Vector[float64]{}).MeanWith(SumPairwise); err == nil {
+		t.Error("MeanWith() on empty vector: expected error")
+	}
+}
+
+func TestStdDevLargeOffset(t *testing.T) {
+	// A large common offset makes the squared deviations tiny compared to the
+	// values, where accumulated rounding error would show up.
+	v := Vector[float64]{Element: make([]float64, 100001)}
+	for i := range v.Element {
+		v.Element[i] = 1e9 + float64(i%2)
+	}
+	want := math.Sqrt(0.25 - 0.25/float64(len(v.Element))/float64(len(v.Element)))
+
+	if got := v.StdDev(); math.Abs(got-want) > 1e-9 {
+		t.Errorf("StdDev() = %v, want %v", got, want)
+	}
+}
+
+func BenchmarkSumWith(b *testing.B) {
+	v := Vector[float64]{Element: make([]float64, 10000)}
+	for i := range v.Element {
+		v.Element[i] = float64(i)
+	}
+
+	for _, bm := range []struct {
+		name   string
+		method SumMethod
+	}{{"naive", SumNaive}, {"kahan", SumKahan}, {"pairwise", SumPairwise}} {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				v.SumWith(bm.method)
+			}
+		})
+	}
+}
@@ -2,45 +2,106 @@ package data
 
 import (
 	"errors"
+	"iter"
 	"math"
 	"slices"
+
+	"github.com/wendersoon/gomathx/parallel"
 )
 
 // Vector represents a generic vector
 type Vector[T Number] struct {
 	Element []T
+	// Stride is the distance in Element between consecutive elements of a
+	// strided view created by Slice. Zero and one both mean the elements are
+	// contiguous, which is the case for every vector that is not a view.
+	Stride int
+}
+
+// step returns the effective stride of the vector
+func (v *Vector[T]) step() int {
+	if v.Stride <= 1 {
+		return 1
+	}
+	return v.Stride
 }
 
 // Len returns the number of elements in the vector
 func (v *Vector[T]) Len() int {
-	return len(v.Element)
+	s := v.step()
+	return (len(v.Element) + s - 1) / s
+}
+
+// At returns the element at index i, honoring the stride of views.
+// It panics if i is out of range, like indexing a slice.
+func (v *Vector[T]) At(i int) T {
+	return v.Element[i*v.step()]
+}
+
+// Set sets the element at index i, honoring the stride of views.
+// It panics if i is out of range, like indexing a slice.
+func (v *Vector[T]) Set(i int, val T) {
+	v.Element[i*v.step()] = val
 }
 
-// Sum returns the number of elements in the vector
+// All returns an iterator over the index and value of each element
+func (v *Vector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		s := v.step()
+		for i, j := 0, 0; j < len(v.Element); i, j = i+1, j+s {
+			if !yield(i, v.Element[j]) {
+				return
+			}
+		}
+	}
+}
+
+// span returns the part of Element holding the elements with index in [lo, hi)
+func (v *Vector[T]) span(lo, hi int) []T {
+	if hi <= lo {
+		return nil
+	}
+	s := v.step()
+	return v.Element[lo*s : (hi-1)*s+1]
+}
+
+// Sum returns the sum of the elements in the vector.
+// Large vectors are summed in parallel when enabled in the parallel package.
 func (v *Vector[T]) Sum() T {
+	if n := v.Len(); parallel.Active(n) {
+		return parallel.Reduce(n, v.sumRange, func(x, y T) T { return x + y })
+	}
+	return v.sumRange(0, v.Len())
+}
+
+// sumRange returns the sum of the elements with index in [lo, hi)
+func (v *Vector[T]) sumRange(lo, hi int) T {
 	var total T
-	for _, val := range v.Element {
-		total += val
+	e := v.span(lo, hi)
+	for j, s := 0, v.step(); j < len(e); j += s {
+		total += e[j]
 	}
 	return total
 }
 
-// Mean returns the average value of the vector
+// Mean returns the average value of the vector.
+// The sum is accumulated in float64 with Kahan-Neumaier summation.
 func (v *Vector[T]) Mean() (float64, error) {
-	if v.Len() == 0 {
-		return 0, errors.New("cannot calculate mean of empty vector")
-	}
-	return float64(v.Sum()) / float64(v.Len()), nil
+	return v.MeanWith(SumKahan)
 }
 
-// Max returns the maximum value in the vector
+// Max returns the maximum value in the vector.
+// NaN is returned if the vector contains NaN; see MaxPolicy.
 func (v *Vector[T]) Max() (T, error) {
 	if v.Len() == 0 {
 		var zero T
 		return zero, errors.New("cannot calculate max of empty vector")
 	}
-	max := v.Element[0]
-	for _, val := range v.Element[1:] {
+	max := v.At(0)
+	for _, val := range v.All() {
+		if val != val {
+			return val, nil
+		}
 		if val > max {
 			max = val
 		}
@@ -48,14 +109,18 @@ func (v *Vector[T]) Max() (T, error) {
 	return max, nil
 }
 
-// Min returns the minimum value in the vector
+// Min returns the minimum value in the vector.
+// NaN is returned if the vector contains NaN; see MinPolicy.
 func (v *Vector[T]) Min() (T, error) {
 	if v.Len() == 0 {
 		var zero T
 		return zero, errors.New("cannot calculate min of empty vector")
 	}
-	min := v.Element[0]
-	for _, val := range v.Element[1:] {
+	min := v.At(0)
+	for _, val := range v.All() {
+		if val != val {
+			return val, nil
+		}
 		if val < min {
 			min = val
 		}
@@ -66,7 +131,7 @@ func (v *Vector[T]) Min() (T, error) {
 // Normalize returns a new vector scaled to unit length (Euclidean norm = 1)
 func (v *Vector[T]) Normalize() (*Vector[float64], error) {
 	var sumSquares float64
-	for _, val := range v.Element {
+	for _, val := range v.All() {
 		fVal := float64(val)
 		sumSquares += fVal * fVal
 	}
@@ -77,31 +142,39 @@ func (v *Vector[T]) Normalize() (*Vector[float64], error) {
 
 	norm := math.Sqrt(sumSquares)
 	normalized := make([]float64, v.Len())
-	for i, val := range v.Element {
+	for i, val := range v.All() {
 		normalized[i] = float64(val) / norm
 	}
 
 	return &Vector[float64]{Element: normalized}, nil
 }
 
-// Clone returns a copy of the current vector
+// Clone returns a contiguous copy of the current vector or view
 func (v *Vector[T]) Clone() *Vector[T] {
 	cloned := make([]T, v.Len())
-	copy(cloned, v.Element)
+	if v.IsContiguous() {
+		copy(cloned, v.Element)
+	} else {
+		for i, val := range v.All() {
+			cloned[i] = val
+		}
+	}
 	return &Vector[T]{Element: cloned}
 }
 
 // Reverse reverses the elements in place
 func (v *Vector[T]) Reverse() {
 	for i, j := 0, v.Len()-1; i < j; i, j = i+1, j-1 {
-		v.Element[i], v.Element[j] = v.Element[j], v.Element[i]
+		vi, vj := v.At(i), v.At(j)
+		v.Set(i, vj)
+		v.Set(j, vi)
 	}
 }
 
 // Abs returns a new a vector with absolute values (only meaningful for signed types)
 func (v *Vector[T]) Abs() *Vector[float64] {
 	absVec := make([]float64, v.Len())
-	for i, val := range v.Element {
+	for i, val := range v.All() {
 		absVec[i] = math.Abs(float64(val))
 	}
 
@@ -111,18 +184,23 @@ func (v *Vector[T]) Abs() *Vector[float64] {
 // Scale returns a new vector where each element is multiplied by a scalar
 func (v *Vector[T]) Scale(scalar T) *Vector[T] {
 	scaled := make([]T, v.Len())
-	for i, val := range v.Element {
+	for i, val := range v.All() {
 		scaled[i] = val * scalar
 	}
 	return &Vector[T]{Element: scaled}
 }
 
-// Apply applies a function to each element and returns a new vector
+// Apply applies a function to each element and returns a new vector.
+// Large vectors are processed in parallel when enabled in the parallel
+// package, in which case f must be safe for concurrent use.
 func (v *Vector[T]) Apply(f func(T) T) *Vector[T] {
 	result := make([]T, v.Len())
-	for i, val := range v.Element {
-		result[i] = f(val)
-	}
+	parallel.For(len(result), func(lo, hi int) {
+		e, s := v.span(lo, hi), v.step()
+		for i, j := lo, 0; j < len(e); i, j = i+1, j+s {
+			result[i] = f(e[j])
+		}
+	})
 	return &Vector[T]{Element: result}
 }
 
@@ -130,7 +208,7 @@ func (v *Vector[T]) Apply(f func(T) T) *Vector[T] {
 func (v *Vector[T]) Cumsum() *Vector[T] {
 	result := make([]T, v.Len())
 	var sum T
-	for i, val := range v.Element {
+	for i, val := range v.All() {
 		sum += val
 		result[i] = sum
 	}
@@ -144,67 +222,95 @@ func (v *Vector[T]) Diff() *Vector[T] {
 	}
 	diff := make([]T, v.Len()-1)
 	for i := 1; i < v.Len(); i++ {
-		diff[i-1] = v.Element[i] - v.Element[i-1]
+		diff[i-1] = v.At(i) - v.At(i-1)
 	}
 	return &Vector[T]{Element: diff}
 }
 
-// ArgMax returns the index of the maximum value in the vector
+// ArgMax returns the index of the maximum value in the vector, or of the
+// first NaN if there is one; see ArgMaxPolicy.
 func (v *Vector[T]) ArgMax() int {
 	if v.Len() == 0 {
 		return -1
 	}
 	maxIdx := 0
-	for i := 1; i < v.Len(); i++ {
-		if v.Element[i] > v.Element[maxIdx] {
+	for i := 0; i < v.Len(); i++ {
+		if v.At(i) != v.At(i) {
+			return i
+		}
+		if v.At(i) > v.At(maxIdx) {
 			maxIdx = i
 		}
 	}
 	return maxIdx
 }
 
-// ArgMin returns the index of the minimum value in the vector
+// ArgMin returns the index of the minimum value in the vector, or of the
+// first NaN if there is one; see ArgMinPolicy.
 func (v *Vector[T]) ArgMin() int {
 	if v.Len() == 0 {
 		return -1
 	}
 	minIdx := 0
-	for i := 1; i < v.Len(); i++ {
-		if v.Element[i] < v.Element[minIdx] {
+	for i := 0; i < v.Len(); i++ {
+		if v.At(i) != v.At(i) {
+			return i
+		}
+		if v.At(i) < v.At(minIdx) {
 			minIdx = i
 		}
 	}
 	return minIdx
 }
 
-// Sort sorts the vector in ascending order
+// Sort sorts the vector in ascending order. NaNs are ordered before all
+// other values, as with slices.Sort.
 func (v *Vector[T]) Sort() {
-	slices.Sort(v.Element)
+	if v.IsContiguous() {
+		slices.Sort(v.Element)
+		return
+	}
+	sorted := v.Clone()
+	slices.Sort(sorted.Element)
+	for i, val := range sorted.Element {
+		v.Set(i, val)
+	}
 }
 
-// StdDev returns the standard deviation of the vector elements
+// StdDev returns the population standard deviation of the vector elements,
+// dividing by n. Use StdDevDDOF(1) for the sample standard deviation.
+// Both passes over the data use Kahan-Neumaier summation.
 func (v *Vector[T]) StdDev() float64 {
-	n := float64(v.Len())
-	if n == 0 {
+	if v.Len() == 0 {
 		return 0
 	}
-	var sum, mean, sqDiff float64
-	for _, val := range v.Element {
-		sum += float64(val)
-	}
-	mean = sum / n
-	for _, val := range v.Element {
-		diff := float64(val) - mean
-		sqDiff += diff * diff
-	}
-	return math.Sqrt(sqDiff / n)
+	mean, _ := v.MeanWith(SumKahan)
+	return math.Sqrt(v.sumSquaredDeviations(mean) / float64(v.Len()))
+}
+
+// sumSquaredDeviations returns the sum of (x - mean)^2 over the elements
+func (v *Vector[T]) sumSquaredDeviations(mean float64) float64 {
+	e, s := v.Element, v.step()
+	return SumFunc(v.Len(), func(i int) float64 {
+		diff := float64(e[i*s]) - mean
+		return diff * diff
+	}, SumKahan)
 }
 
-// Unique returns a new vector with unique elements
+// Unique returns a new vector with unique elements.
+// All NaNs are treated as equal and kept once, at their first position.
 func (v *Vector[T]) Unique() *Vector[T] {
 	seen := make(map[T]struct{})
 	unique := make([]T, 0, v.Len())
-	for _, val := range v.Element {
+	seenNaN := false
+	for _, val := range v.All() {
+		if val != val {
+			if !seenNaN {
+				seenNaN = true
+				unique = append(unique, val)
+			}
+			continue
+		}
 		if _, ok := seen[val]; !ok {
 			seen[val] = struct{}{}
 			unique = append(unique, val)
@@ -0,0 +1,26 @@
+package data
+
+// IsContiguous reports whether the elements are adjacent in Element
+func (v *Vector[T]) IsContiguous() bool {
+	return v.step() == 1
+}
+
+// Slice returns a view of the elements start, start+step, ... up to but not
+// including end. The view shares the backing array with v, so writes through
+// either are visible in both; use Clone to obtain an independent copy.
+// Returns ErrInvalidSlice unless 0 <= start <= end <= Len() and step >= 1.
+func (v *Vector[T]) Slice(start, end, step int) (*Vector[T], error) {
+	if start < 0 || end < start || end > v.Len() || step < 1 {
+		return nil, ErrInvalidSlice
+	}
+	s := v.step()
+	count := (end - start + step - 1) / step
+	if count == 0 {
+		first := min(start*s, len(v.Element))
+		return &Vector[T]{Element: v.Element[first:first:first]}, nil
+	}
+	first := start * s
+	last := (start + (count-1)*step) * s
+	// Cap the capacity so appending to the view cannot overwrite the parent
+	return &Vector[T]{Element: v.Element[first : last+1 : last+1], Stride: s * step}, nil
+}
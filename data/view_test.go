@@ -0,0 +1,132 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVectorSlice(t *testing.T) {
+	tests := []struct {
+		name             string
+		start, end, step int
+		expected         []int
+	}{
+		{"Full range", 0, 8, 1, []int{0, 1, 2, 3, 4, 5, 6, 7}},
+		{"Sub-range", 2, 5, 1, []int{2, 3, 4}},
+		{"Every other", 0, 8, 2, []int{0, 2, 4, 6}},
+		{"Step past end", 1, 8, 3, []int{1, 4, 7}},
+		{"Single element", 5, 6, 4, []int{5}},
+		{"Empty", 3, 3, 1, []int{}},
+		{"Empty at end", 8, 8, 2, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Vector[int]{Element: []int{0, 1, 2, 3, 4, 5, 6, 7}}
+			view, err := v.Slice(tt.start, tt.end, tt.step)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if view.Len() != len(tt.expected) {
+				t.Errorf("Len() = %v, want %v", view.Len(), len(tt.expected))
+			}
+			if got := view.Clone().Element; !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Slice(%d, %d, %d) = %v, want %v", tt.start, tt.end, tt.step, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestVectorSliceInvalid(t *testing.T) {
+	v := Vector[int]{Element: []int{1, 2, 3}}
+	bounds := [][3]int{{-1, 2, 1}, {2, 1, 1}, {0, 4, 1}, {0, 3, 0}}
+
+	for _, b := range bounds {
+		if _, err := v.Slice(b[0], b[1], b[2]); err != ErrInvalidSlice {
+			t.Errorf("Slice(%d, %d, %d) error = %v, want ErrInvalidSlice", b[0], b[1], b[2], err)
+		}
+	}
+}
+
+func TestVectorSliceSharesStorage(t *testing.T) {
+	v := Vector[int]{Element: []int{0, 1, 2, 3, 4, 5}}
+	view, _ := v.Slice(1, 6, 2) // [1, 3, 5]
+
+	view.Set(1, 30)
+	if v.Element[3] != 30 {
+		t.Errorf("write through view not visible in parent: %v", v.Element)
+	}
+
+	v.Element[5] = 50
+	if view.At(2) != 50 {
+		t.Errorf("write to parent not visible in view: At(2) = %v", view.At(2))
+	}
+
+	// Appending to a view must not clobber the parent
+	_ = append(view.Element, 99)
+	if !reflect.DeepEqual(v.Element, []int{0, 1, 2, 30, 4, 50}) {
+		t.Errorf("append to view modified parent: %v", v.Element)
+	}
+}
+
+func TestVectorSliceOfView(t *testing.T) {
+	v := Vector[int]{Element: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}}
+	evens, _ := v.Slice(0, 12, 2)      // [0, 2, 4, 6, 8, 10]
+	inner, err := evens.Slice(1, 6, 2) // [2, 6, 10]
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got := inner.Clone().Element; !reflect.DeepEqual(got, []int{2, 6, 10}) {
+		t.Errorf("nested Slice() = %v, want [2 6 10]", got)
+	}
+	if inner.Stride != 4 {
+		t.Errorf("nested Slice() stride = %v, want 4", inner.Stride)
+	}
+}
+
+func TestVectorAll(t *testing.T) {
+	v := Vector[int]{Element: []int{10, 11, 12, 13, 14}, Stride: 2}
+
+	var indices, values []int
+	for i, val := range v.All() {
+		indices = append(indices, i)
+		values = append(values, val)
+	}
+
+	if !reflect.DeepEqual(indices, []int{0, 1, 2}) || !reflect.DeepEqual(values, []int{10, 12, 14}) {
+		t.Errorf("All() yielded %v, %v", indices, values)
+	}
+}
+
+func TestVectorViewOperations(t *testing.T) {
+	v := Vector[int]{Element: []int{5, 0, 3, 0, 9, 0, 1}}
+	view, _ := v.Slice(0, 7, 2) // [5, 3, 9, 1]
+
+	if got := view.Sum(); got != 18 {
+		t.Errorf("Sum() = %v, want 18", got)
+	}
+	if got, _ := view.Max(); got != 9 {
+		t.Errorf("Max() = %v, want 9", got)
+	}
+	if got := view.ArgMin(); got != 3 {
+		t.Errorf("ArgMin() = %v, want 3", got)
+	}
+	if got := view.Diff().Element; !reflect.DeepEqual(got, []int{-2, 6, -8}) {
+		t.Errorf("Diff() = %v, want [-2 6 -8]", got)
+	}
+
+	view.Sort()
+	if !reflect.DeepEqual(v.Element, []int{1, 0, 3, 0, 5, 0, 9}) {
+		t.Errorf("Sort() on view = %v, want [1 0 3 0 5 0 9]", v.Element)
+	}
+
+	view.Reverse()
+	if !reflect.DeepEqual(v.Element, []int{9, 0, 5, 0, 3, 0, 1}) {
+		t.Errorf("Reverse() on view = %v, want [9 0 5 0 3 0 1]", v.Element)
+	}
+
+	if !view.Clone().IsContiguous() {
+		t.Errorf("Clone() of a view should be contiguous")
+	}
+}
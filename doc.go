@@ -38,5 +38,7 @@
 //
 //   - data: Core data structures and vector operations
 //   - vector: Vector creation and arithmetic operations
-//   - matrix: Matrix operations (coming soon)
+//   - matrix: Dense and sparse (CSR) matrices, products, LU, QR, Cholesky,
+//     eigen and SVD decompositions, and iterative solvers
+//   - parallel: Opt-in parallel execution for large vectors
 package gomathx
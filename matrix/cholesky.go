@@ -0,0 +1,122 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// Cholesky holds the Cholesky factorization A = L*L^T of a symmetric
+// positive-definite matrix, with L lower triangular.
+type Cholesky struct {
+	l *Matrix[float64]
+}
+
+// DecomposeCholesky computes the Cholesky factorization of m.
+// Returns ErrNotSquare or ErrNotSymmetric for invalid input and a
+// *NotPositiveDefiniteError if m is not positive definite.
+func DecomposeCholesky[T data.Number](m *Matrix[T]) (*Cholesky, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	a := toFloat64(m)
+	if !isSymmetric(a) {
+		return nil, ErrNotSymmetric
+	}
+	n := a.Rows
+	l := make([]float64, n*n)
+
+	for j := 0; j < n; j++ {
+		d := a.Element[j*n+j]
+		for k := 0; k < j; k++ {
+			d -= l[j*n+k] * l[j*n+k]
+		}
+		if d <= 0 || math.IsNaN(d) {
+			return nil, &NotPositiveDefiniteError{Column: j}
+		}
+		ljj := math.Sqrt(d)
+		l[j*n+j] = ljj
+
+		for i := j + 1; i < n; i++ {
+			s := a.Element[i*n+j]
+			for k := 0; k < j; k++ {
+				s -= l[i*n+k] * l[j*n+k]
+			}
+			l[i*n+j] = s / ljj
+		}
+	}
+	return &Cholesky{l: &Matrix[float64]{Rows: n, Cols: n, Element: l}}, nil
+}
+
+// L returns a copy of the lower triangular factor
+func (c *Cholesky) L() *Matrix[float64] {
+	return c.l.Clone()
+}
+
+// Det returns the determinant of the factorized matrix
+func (c *Cholesky) Det() float64 {
+	n := c.l.Rows
+	det := 1.0
+	for i := 0; i < n; i++ {
+		d := c.l.Element[i*n+i]
+		det *= d * d
+	}
+	return det
+}
+
+// Solve solves A*x = b for x using forward and back substitution with L.
+// Returns ErrMismatchedDimensions if b does not match the matrix size.
+func (c *Cholesky) Solve(b *data.Vector[float64]) (*data.Vector[float64], error) {
+	n := c.l.Rows
+	if b.Len() != n {
+		return nil, ErrMismatchedDimensions
+	}
+	l := c.l.Element
+	x := b.Clone().Element
+
+	// Solve L*y = b
+	for i := 0; i < n; i++ {
+		for k := 0; k < i; k++ {
+			x[i] -= l[i*n+k] * x[k]
+		}
+		x[i] /= l[i*n+i]
+	}
+	// Solve L^T*x = y
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			x[i] -= l[k*n+i] * x[k]
+		}
+		x[i] /= l[i*n+i]
+	}
+	return &data.Vector[float64]{Element: x}, nil
+}
+
+// SolveSPD solves a*x = b for a symmetric positive-definite matrix a.
+// It needs about half the floating-point work of Solve and returns a
+// *NotPositiveDefiniteError if a turns out not to be positive definite.
+func SolveSPD[T data.Number](a *Matrix[T], b *data.Vector[float64]) (*data.Vector[float64], error) {
+	if a.Rows != b.Len() {
+		return nil, ErrMismatchedDimensions
+	}
+	c, err := DecomposeCholesky(a)
+	if err != nil {
+		return nil, err
+	}
+	return c.Solve(b)
+}
+
+// isSymmetric reports whether a equals its transpose to working precision.
+// Off-diagonal NaNs are never symmetric.
+func isSymmetric(a *Matrix[float64]) bool {
+	n := a.Rows
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			x, y := a.Element[i*n+j], a.Element[j*n+i]
+			// Written as a negated <= so that a NaN in either entry fails
+			if !(math.Abs(x-y) <= 8*epsilon*math.Max(math.Abs(x), math.Abs(y))) {
+				return false
+			}
+		}
+	}
+	return true
+}
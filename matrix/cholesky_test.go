@@ -0,0 +1,119 @@
+package matrix_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/matrix"
+)
+
+// TestDecomposeCholesky tests the Cholesky factorization
+func TestDecomposeCholesky_Reconstruct(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]int{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}})
+
+	c, err := matrix.DecomposeCholesky(a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []float64{2, 0, 0, 6, 1, 0, -8, 5, 3}
+	l := c.L()
+	for i, val := range l.Element {
+		if math.Abs(val-expected[i]) > 1e-12 {
+			t.Fatalf("L = %v, want %v", l.Element, expected)
+		}
+	}
+
+	if math.Abs(c.Det()-36) > 1e-9 {
+		t.Errorf("Det() = %v, want 36", c.Det())
+	}
+}
+
+func TestDecomposeCholesky_NotPositiveDefinite(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{1, 2, 0}, {2, 1, 0}, {0, 0, 1}})
+
+	_, err := matrix.DecomposeCholesky(a)
+
+	var npd *matrix.NotPositiveDefiniteError
+	if !errors.As(err, &npd) {
+		t.Fatalf("expected *NotPositiveDefiniteError, got: %v", err)
+	}
+	if npd.Column != 1 {
+		t.Errorf("expected failing column 1, got %d", npd.Column)
+	}
+}
+
+func TestDecomposeCholesky_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     [][]float64
+		expected error
+	}{
+		{"Not square", [][]float64{{1, 0, 0}, {0, 1, 0}}, matrix.ErrNotSquare},
+		{"Not symmetric", [][]float64{{2, 1}, {0, 2}}, matrix.ErrNotSymmetric},
+		{"NaN above diagonal", [][]float64{{2, math.NaN()}, {1, 3}}, matrix.ErrNotSymmetric},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := matrix.CreateMatrix(tt.rows)
+			if _, err := matrix.DecomposeCholesky(a); err != tt.expected {
+				t.Errorf("expected %v, got: %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+// TestSolveSPD tests the SolveSPD function
+func TestSolveSPD_MatchesSolve(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{4, 1, 2}, {1, 3, 0}, {2, 0, 5}})
+	b := &data.Vector[float64]{Element: []float64{1, 2, 3}}
+
+	got, err := matrix.SolveSPD(a, b)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	want, _ := matrix.Solve(a, b)
+
+	for i := range want.Element {
+		if math.Abs(got.Element[i]-want.Element[i]) > 1e-12 {
+			t.Errorf("SolveSPD()[%d] = %v, Solve()[%d] = %v", i, got.Element[i], i, want.Element[i])
+		}
+	}
+}
+
+func TestSolveSPD_MismatchedDimensions(t *testing.T) {
+	a, _ := matrix.Identity[float64](3)
+	b := &data.Vector[float64]{Element: []float64{1, 2}}
+
+	_, err := matrix.SolveSPD(a, b)
+
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+// spdMatrix returns a well-conditioned n x n symmetric positive-definite matrix
+func spdMatrix(n int) *matrix.Matrix[float64] {
+	x := filledMatrix(n, n, 1)
+	a, _ := matrix.Mul(x.Transpose(), x)
+	for i := 0; i < n; i++ {
+		a.Element[i*n+i] += float64(n)
+	}
+	return a
+}
+
+func BenchmarkSolveSPD100(b *testing.B) {
+	a := spdMatrix(100)
+	rhs := &data.Vector[float64]{Element: make([]float64, 100)}
+	for i := range rhs.Element {
+		rhs.Element[i] = float64(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix.SolveSPD(a, rhs)
+	}
+}
@@ -0,0 +1,139 @@
+package matrix
+
+import (
+	"sort"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// CSR represents a generic sparse matrix in compressed sparse row format.
+// The stored elements of row i are Values[RowPtr[i]:RowPtr[i+1]], located in
+// the columns ColIndex[RowPtr[i]:RowPtr[i+1]], which are strictly increasing.
+type CSR[T data.Number] struct {
+	Rows     int
+	Cols     int
+	RowPtr   []int
+	ColIndex []int
+	Values   []T
+}
+
+// CreateCSR creates a sparse matrix from its raw CSR arrays.
+// Returns ErrEmptyMatrix for non-positive dimensions and ErrInvalidStructure
+// if the arrays are inconsistent.
+func CreateCSR[T data.Number](rows, cols int, rowPtr, colIndex []int, values []T) (*CSR[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, ErrEmptyMatrix
+	}
+	if len(rowPtr) != rows+1 || rowPtr[0] != 0 || rowPtr[rows] != len(colIndex) || len(colIndex) != len(values) {
+		return nil, ErrInvalidStructure
+	}
+	for i := 0; i < rows; i++ {
+		if rowPtr[i+1] < rowPtr[i] {
+			return nil, ErrInvalidStructure
+		}
+		for k := rowPtr[i]; k < rowPtr[i+1]; k++ {
+			if colIndex[k] < 0 || colIndex[k] >= cols || (k > rowPtr[i] && colIndex[k] <= colIndex[k-1]) {
+				return nil, ErrInvalidStructure
+			}
+		}
+	}
+	return &CSR[T]{Rows: rows, Cols: cols, RowPtr: rowPtr, ColIndex: colIndex, Values: values}, nil
+}
+
+// CSRFromDense creates a sparse matrix holding the non-zero elements of m
+func CSRFromDense[T data.Number](m *Matrix[T]) *CSR[T] {
+	c := &CSR[T]{Rows: m.Rows, Cols: m.Cols, RowPtr: make([]int, m.Rows+1)}
+	for i := 0; i < m.Rows; i++ {
+		for j, val := range m.Element[i*m.Cols : (i+1)*m.Cols] {
+			if val != 0 {
+				c.ColIndex = append(c.ColIndex, j)
+				c.Values = append(c.Values, val)
+			}
+		}
+		c.RowPtr[i+1] = len(c.Values)
+	}
+	return c
+}
+
+// CSRFromRows creates a sparse matrix whose rows are the given sparse vectors.
+// All vectors must have the same dimension.
+func CSRFromRows[T data.Number](rows ...*data.SparseVector[T]) (*CSR[T], error) {
+	if len(rows) == 0 || rows[0].Dim == 0 {
+		return nil, ErrEmptyMatrix
+	}
+	c := &CSR[T]{Rows: len(rows), Cols: rows[0].Dim, RowPtr: make([]int, len(rows)+1)}
+	for i, row := range rows {
+		if row.Dim != c.Cols {
+			return nil, ErrMismatchedDimensions
+		}
+		c.ColIndex = append(c.ColIndex, row.Indices...)
+		c.Values = append(c.Values, row.Values...)
+		c.RowPtr[i+1] = len(c.Values)
+	}
+	return c, nil
+}
+
+// Dims returns the number of rows and columns of the matrix
+func (c *CSR[T]) Dims() (int, int) {
+	return c.Rows, c.Cols
+}
+
+// Nnz returns the number of stored elements
+func (c *CSR[T]) Nnz() int {
+	return len(c.Values)
+}
+
+// At returns the element at row i and column j, which is zero if it is not stored
+func (c *CSR[T]) At(i, j int) (T, error) {
+	var zero T
+	if i < 0 || i >= c.Rows || j < 0 || j >= c.Cols {
+		return zero, ErrIndexOutOfRange
+	}
+	cols := c.ColIndex[c.RowPtr[i]:c.RowPtr[i+1]]
+	k := sort.SearchInts(cols, j)
+	if k < len(cols) && cols[k] == j {
+		return c.Values[c.RowPtr[i]+k], nil
+	}
+	return zero, nil
+}
+
+// Row returns a copy of row i as a sparse vector
+func (c *CSR[T]) Row(i int) (*data.SparseVector[T], error) {
+	if i < 0 || i >= c.Rows {
+		return nil, ErrIndexOutOfRange
+	}
+	lo, hi := c.RowPtr[i], c.RowPtr[i+1]
+	indices := make([]int, hi-lo)
+	copy(indices, c.ColIndex[lo:hi])
+	values := make([]T, hi-lo)
+	copy(values, c.Values[lo:hi])
+	return &data.SparseVector[T]{Dim: c.Cols, Indices: indices, Values: values}, nil
+}
+
+// ToDense returns a dense matrix with the same elements
+func (c *CSR[T]) ToDense() *Matrix[T] {
+	m := &Matrix[T]{Rows: c.Rows, Cols: c.Cols, Element: make([]T, c.Rows*c.Cols)}
+	for i := 0; i < c.Rows; i++ {
+		for k := c.RowPtr[i]; k < c.RowPtr[i+1]; k++ {
+			m.Element[i*c.Cols+c.ColIndex[k]] = c.Values[k]
+		}
+	}
+	return m
+}
+
+// CSRMulVec computes the sparse-dense matrix-vector product c * v.
+// Returns ErrMismatchedDimensions if c.Cols != v.Len().
+func CSRMulVec[T data.Number](c *CSR[T], v *data.Vector[T]) (*data.Vector[T], error) {
+	if c.Cols != v.Len() {
+		return nil, ErrMismatchedDimensions
+	}
+	result := make([]T, c.Rows)
+	for i := 0; i < c.Rows; i++ {
+		var sum T
+		for k := c.RowPtr[i]; k < c.RowPtr[i+1]; k++ {
+			sum += c.Values[k] * v.At(c.ColIndex[k])
+		}
+		result[i] = sum
+	}
+	return &data.Vector[T]{Element: result}, nil
+}
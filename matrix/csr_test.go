@@ -0,0 +1,150 @@
+package matrix_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/matrix"
+)
+
+// TestCreateCSR tests the CreateCSR function
+func TestCreateCSR_Success(t *testing.T) {
+	// [[1 0 2]
+	//  [0 0 0]
+	//  [0 3 0]]
+	c, err := matrix.CreateCSR(3, 3, []int{0, 2, 2, 3}, []int{0, 2, 1}, []int{1, 2, 3})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected, _ := matrix.CreateMatrix([][]int{{1, 0, 2}, {0, 0, 0}, {0, 3, 0}})
+	if !matrix.EqualMatrices(c.ToDense(), expected) {
+		t.Errorf("expected %v, got %v", expected.Element, c.ToDense().Element)
+	}
+	if c.Nnz() != 3 {
+		t.Errorf("expected 3 stored elements, got %d", c.Nnz())
+	}
+}
+
+func TestCreateCSR_InvalidStructure(t *testing.T) {
+	tests := []struct {
+		name     string
+		rowPtr   []int
+		colIndex []int
+		values   []int
+	}{
+		{"Short row pointer", []int{0, 1}, []int{0}, []int{1}},
+		{"Decreasing row pointer", []int{0, 2, 1, 2}, []int{0, 1}, []int{1, 1}},
+		{"Unsorted columns", []int{0, 2, 2, 2}, []int{2, 0}, []int{1, 1}},
+		{"Column out of range", []int{0, 1, 1, 1}, []int{3}, []int{1}},
+		{"Values mismatch", []int{0, 1, 1, 1}, []int{0}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := matrix.CreateCSR(3, 3, tt.rowPtr, tt.colIndex, tt.values)
+			if err != matrix.ErrInvalidStructure {
+				t.Errorf("expected ErrInvalidStructure, got: %v", err)
+			}
+		})
+	}
+}
+
+// TestCSRFromDense tests conversion from a dense matrix
+func TestCSRFromDense(t *testing.T) {
+	m, _ := matrix.CreateMatrix([][]float64{{0, 1.5, 0}, {2, 0, 0}})
+
+	c := matrix.CSRFromDense(m)
+
+	if !reflect.DeepEqual(c.RowPtr, []int{0, 1, 2}) {
+		t.Errorf("expected row pointer [0 1 2], got %v", c.RowPtr)
+	}
+	if !matrix.EqualMatrices(c.ToDense(), m) {
+		t.Errorf("round trip = %v, want %v", c.ToDense().Element, m.Element)
+	}
+
+	got, _ := c.At(0, 1)
+	if got != 1.5 {
+		t.Errorf("At(0, 1) = %v, want 1.5", got)
+	}
+	if _, err := c.At(2, 0); err != matrix.ErrIndexOutOfRange {
+		t.Errorf("expected ErrIndexOutOfRange, got: %v", err)
+	}
+}
+
+// TestCSRFromRows tests construction from sparse vectors
+func TestCSRFromRows(t *testing.T) {
+	r0 := &data.SparseVector[int]{Dim: 4, Indices: []int{1, 3}, Values: []int{5, 6}}
+	r1 := &data.SparseVector[int]{Dim: 4}
+
+	c, err := matrix.CSRFromRows(r0, r1)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	row, _ := c.Row(0)
+	if !reflect.DeepEqual(row, r0) {
+		t.Errorf("Row(0) = %+v, want %+v", row, r0)
+	}
+
+	bad := &data.SparseVector[int]{Dim: 5}
+	if _, err := matrix.CSRFromRows(r0, bad); err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+// TestCSRMulVec tests the sparse-dense matrix-vector product
+func TestCSRMulVec_MatchesDense(t *testing.T) {
+	m, _ := matrix.CreateMatrix([][]int{{1, 0, 2}, {0, 0, 0}, {0, 3, 0}, {4, 0, 5}})
+	v := &data.Vector[int]{Element: []int{1, 2, 3}}
+
+	got, err := matrix.CSRMulVec(matrix.CSRFromDense(m), v)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	want, _ := matrix.MulVec(m, v)
+
+	if !reflect.DeepEqual(got.Element, want.Element) {
+		t.Errorf("expected %v, got %v", want.Element, got.Element)
+	}
+}
+
+func TestCSRMulVec_MismatchedDimensions(t *testing.T) {
+	c, _ := matrix.CreateCSR(2, 3, []int{0, 0, 0}, []int{}, []int{})
+	v := &data.Vector[int]{Element: []int{1, 2}}
+
+	_, err := matrix.CSRMulVec(c, v)
+
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+func BenchmarkCSRMulVec(b *testing.B) {
+	// 10000x10000 with 10 entries per row
+	n := 10000
+	rowPtr := make([]int, n+1)
+	var colIndex []int
+	var values []float64
+	for i := 0; i < n; i++ {
+		for k := 0; k < 10; k++ {
+			colIndex = append(colIndex, (i*7+k*997)%n)
+			values = append(values, float64(k+1))
+		}
+		slices.Sort(colIndex[len(colIndex)-10:])
+		rowPtr[i+1] = len(values)
+	}
+	c, err := matrix.CreateCSR(n, n, rowPtr, colIndex, values)
+	if err != nil {
+		b.Fatal(err)
+	}
+	v := &data.Vector[float64]{Element: make([]float64, n)}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix.CSRMulVec(c, v)
+	}
+}
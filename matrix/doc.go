@@ -0,0 +1,37 @@
+// matrix/doc.go
+// Package matrix provides a generic dense matrix type and related operations.
+//
+// Matrices are stored in row-major order in a single backing slice, so the
+// element at row i and column j lives at Element[i*Cols+j]. All functions are
+// generic over the data.Number constraint used by the data and vector packages.
+//
+// Key functions include:
+//   - CreateMatrix: Safe matrix creation from a slice of rows
+//   - Zeros: Zero-filled matrix of the given dimensions
+//   - Identity: Square identity matrix
+//   - FromColumns: Matrix built from column vectors
+//   - Mul: Cache-blocked matrix product
+//   - MulVec: Matrix-vector product
+//   - DecomposeLU: LU factorization with partial pivoting
+//   - Det, Inverse, Solve: Dense linear algebra built on LU
+//   - DecomposeQR: Householder QR factorization
+//   - LeastSquares: Least-squares fit for overdetermined systems
+//   - DecomposeCholesky, SolveSPD: Symmetric positive-definite systems
+//   - EigenSym: Eigenvalues and eigenvectors of symmetric matrices
+//   - DecomposeSVD: Singular value decomposition
+//   - Rank, Cond, PseudoInverse: Diagnostics derived from the SVD
+//   - CSR, CSRMulVec: Compressed sparse row matrices and sparse-dense products
+//   - ConjugateGradient, GMRES: Iterative solvers over any LinearOperator
+//
+// Operations between matrices of incompatible shapes return
+// ErrMismatchedDimensions. Factorizations that meet a zero pivot return a
+// *SingularMatrixError carrying the index of the failing column, and
+// DecomposeLU and the functions built on it reject NaN or infinite entries
+// with ErrNonFinite.
+//
+// Example:
+//
+//	m, _ := matrix.CreateMatrix([][]int{{1, 2}, {3, 4}})
+//	row, _ := m.Row(0)  // [1, 2]
+//	col, _ := m.Col(1)  // [2, 4]
+package matrix
@@ -0,0 +1,112 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// DefaultMaxSweeps is the sweep limit used by EigenSym when maxSweeps is not positive.
+// Cyclic Jacobi converges quadratically, so well-behaved matrices need fewer than ten.
+const DefaultMaxSweeps = 50
+
+// EigenSym computes the eigenvalues and eigenvectors of a symmetric matrix
+// using the cyclic Jacobi method.
+//
+// Eigenvalues are returned in ascending order and column i of the returned
+// matrix is the unit eigenvector for eigenvalue i. At most maxSweeps sweeps
+// over the off-diagonal elements are performed (DefaultMaxSweeps if maxSweeps
+// is not positive); ErrNoConvergence is returned if that is not enough.
+func EigenSym[T data.Number](m *Matrix[T], maxSweeps int) (*data.Vector[float64], *Matrix[float64], error) {
+	if !m.IsSquare() {
+		return nil, nil, ErrNotSquare
+	}
+	a := toFloat64(m)
+	if !isSymmetric(a) {
+		return nil, nil, ErrNotSymmetric
+	}
+	if maxSweeps <= 0 {
+		maxSweeps = DefaultMaxSweeps
+	}
+	n := a.Rows
+	v, _ := Identity[float64](n)
+	scale := normalize(a)
+
+	var frob float64
+	for _, val := range a.Element {
+		frob += val * val
+	}
+	tol := epsilon * epsilon * frob
+
+	converged := false
+	for sweep := 0; sweep <= maxSweeps; sweep++ {
+		var off float64
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off += a.Element[p*n+q] * a.Element[p*n+q]
+			}
+		}
+		if off <= tol {
+			converged = true
+			break
+		}
+		if sweep == maxSweeps {
+			break
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				apq := a.Element[p*n+q]
+				if apq == 0 {
+					continue
+				}
+				// Rotation angle that zeroes a[p][q], computed to avoid cancellation
+				theta := (a.Element[q*n+q] - a.Element[p*n+p]) / (2 * apq)
+				t := 1 / (math.Abs(theta) + math.Hypot(theta, 1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Hypot(t, 1)
+				s := t * c
+
+				for k := 0; k < n; k++ {
+					akp, akq := a.Element[k*n+p], a.Element[k*n+q]
+					a.Element[k*n+p] = c*akp - s*akq
+					a.Element[k*n+q] = s*akp + c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a.Element[p*n+k], a.Element[q*n+k]
+					a.Element[p*n+k] = c*apk - s*aqk
+					a.Element[q*n+k] = s*apk + c*aqk
+				}
+				for k := 0; k < n; k++ {
+					vkp, vkq := v.Element[k*n+p], v.Element[k*n+q]
+					v.Element[k*n+p] = c*vkp - s*vkq
+					v.Element[k*n+q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+	if !converged {
+		return nil, nil, ErrNoConvergence
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return a.Element[order[i]*n+order[i]] < a.Element[order[j]*n+order[j]]
+	})
+
+	values := make([]float64, n)
+	vectors := make([]float64, n*n)
+	for j, src := range order {
+		values[j] = a.Element[src*n+src] * scale
+		for i := 0; i < n; i++ {
+			vectors[i*n+j] = v.Element[i*n+src]
+		}
+	}
+	return &data.Vector[float64]{Element: values}, &Matrix[float64]{Rows: n, Cols: n, Element: vectors}, nil
+}
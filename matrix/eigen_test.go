@@ -0,0 +1,124 @@
+package matrix_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wendersoon/gomathx/matrix"
+)
+
+// TestEigenSym tests the EigenSym function
+func TestEigenSym_KnownValues(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]int{{2, 1, 0}, {1, 2, 0}, {0, 0, 5}})
+
+	values, vectors, err := matrix.EigenSym(a, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []float64{1, 3, 5}
+	for i, val := range values.Element {
+		if math.Abs(val-expected[i]) > 1e-12 {
+			t.Errorf("eigenvalue %d = %v, want %v", i, val, expected[i])
+		}
+	}
+
+	// The eigenvector for eigenvalue 5 is ±e3
+	col, _ := vectors.Col(2)
+	if math.Abs(math.Abs(col.Element[2])-1) > 1e-12 {
+		t.Errorf("eigenvector for 5 = %v, want ±[0 0 1]", col.Element)
+	}
+}
+
+func TestEigenSym_Decomposition(t *testing.T) {
+	a := spdMatrix(12)
+	// Make it indefinite so negative eigenvalues are covered too
+	for i := 0; i < a.Rows; i++ {
+		a.Element[i*a.Cols+i] -= 40
+	}
+
+	values, vectors, err := matrix.EigenSym(a, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	for i := 1; i < values.Len(); i++ {
+		if values.Element[i] < values.Element[i-1] {
+			t.Fatalf("eigenvalues not sorted: %v", values.Element)
+		}
+	}
+
+	// A*v_i = lambda_i*v_i for every eigenpair
+	av, _ := matrix.Mul(a, vectors)
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			got, _ := av.At(i, j)
+			vij, _ := vectors.At(i, j)
+			if math.Abs(got-values.Element[j]*vij) > 1e-9 {
+				t.Fatalf("A*v[%d] differs from lambda*v at row %d: %v vs %v", j, i, got, values.Element[j]*vij)
+			}
+		}
+	}
+
+	// Eigenvectors must be orthonormal
+	vtv, _ := matrix.Mul(vectors.Transpose(), vectors)
+	id, _ := matrix.Identity[float64](a.Rows)
+	for i := range id.Element {
+		if math.Abs(vtv.Element[i]-id.Element[i]) > 1e-10 {
+			t.Fatalf("V^T*V is not the identity")
+		}
+	}
+}
+
+func TestEigenSym_NoConvergence(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{1, 2, 3}, {2, 4, 5}, {3, 5, 6}})
+
+	_, _, err := matrix.EigenSym(a, 1)
+
+	if err != matrix.ErrNoConvergence {
+		t.Errorf("expected ErrNoConvergence, got: %v", err)
+	}
+}
+
+func TestEigenSym_InvalidInput(t *testing.T) {
+	notSquare, _ := matrix.Zeros[float64](2, 3)
+	if _, _, err := matrix.EigenSym(notSquare, 0); err != matrix.ErrNotSquare {
+		t.Errorf("expected ErrNotSquare, got: %v", err)
+	}
+
+	notSymmetric, _ := matrix.CreateMatrix([][]float64{{1, 2}, {3, 4}})
+	if _, _, err := matrix.EigenSym(notSymmetric, 0); err != matrix.ErrNotSymmetric {
+		t.Errorf("expected ErrNotSymmetric, got: %v", err)
+	}
+
+	withNaN, _ := matrix.CreateMatrix([][]float64{{2, math.NaN()}, {1, 3}})
+	if _, _, err := matrix.EigenSym(withNaN, 0); err != matrix.ErrNotSymmetric {
+		t.Errorf("expected ErrNotSymmetric for NaN entry, got: %v", err)
+	}
+}
+
+func TestEigenSym_ExtremeMagnitudes(t *testing.T) {
+	for _, scale := range []float64{1e200, 1e-200} {
+		a, _ := matrix.CreateMatrix([][]float64{{scale, scale / 10}, {scale / 10, scale}})
+
+		values, _, err := matrix.EigenSym(a, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		expected := []float64{0.9 * scale, 1.1 * scale}
+		for i, val := range values.Element {
+			if math.Abs(val-expected[i]) > 1e-12*scale {
+				t.Errorf("scale %g: eigenvalue %d = %v, want %v", scale, i, val, expected[i])
+			}
+		}
+	}
+}
+
+func BenchmarkEigenSym50(b *testing.B) {
+	a := spdMatrix(50)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix.EigenSym(a, 0)
+	}
+}
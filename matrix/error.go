@@ -0,0 +1,54 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrEmptyMatrix is returned when trying to create a matrix without elements
+var ErrEmptyMatrix = errors.New("empty matrix is not allowed")
+
+// ErrMismatchedDimensions is returned when matrix or vector shapes are incompatible
+var ErrMismatchedDimensions = errors.New("matrix dimensions do not match")
+
+// ErrIndexOutOfRange is returned when a row or column index is outside the matrix
+var ErrIndexOutOfRange = errors.New("matrix index out of range")
+
+// ErrInvalidStructure is returned when CSR row pointers or column indices are inconsistent
+var ErrInvalidStructure = errors.New("invalid sparse matrix structure")
+
+// ErrNotSquare is returned when an operation requires a square matrix
+var ErrNotSquare = errors.New("matrix must be square")
+
+// ErrRankDeficient is returned when a matrix does not have full column rank
+var ErrRankDeficient = errors.New("matrix is rank deficient")
+
+// ErrNotSymmetric is returned when an operation requires a symmetric matrix
+var ErrNotSymmetric = errors.New("matrix must be symmetric")
+
+// ErrNoConvergence is returned when an iterative algorithm exceeds its iteration limit
+var ErrNoConvergence = errors.New("algorithm did not converge")
+
+// ErrNonFinite is returned when a factorization is given a matrix containing
+// NaN or infinite entries, which would otherwise be mistaken for singularity
+var ErrNonFinite = errors.New("matrix contains NaN or infinite values")
+
+// SingularMatrixError is returned when a factorization encounters a pivot that
+// is zero to working precision. Pivot is the index of the failing column.
+type SingularMatrixError struct {
+	Pivot int
+}
+
+func (e *SingularMatrixError) Error() string {
+	return fmt.Sprintf("matrix is singular: zero pivot at index %d", e.Pivot)
+}
+
+// NotPositiveDefiniteError is returned when a Cholesky factorization finds a
+// non-positive diagonal entry. Column is the index of the failing column.
+type NotPositiveDefiniteError struct {
+	Column int
+}
+
+func (e *NotPositiveDefiniteError) Error() string {
+	return fmt.Sprintf("matrix is not positive definite: failed at column %d", e.Column)
+}
@@ -0,0 +1,304 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// DefaultTol is the relative residual tolerance used by the iterative solvers
+// when IterativeOptions.Tol is not positive.
+const DefaultTol = 1e-10
+
+// LinearOperator is anything that can compute the product y = A*x without
+// necessarily storing A. Apply must write A*x into y, which has already been
+// allocated with the operator's number of rows.
+type LinearOperator interface {
+	Apply(x, y *data.Vector[float64])
+}
+
+// IterativeOptions configures ConjugateGradient and GMRES.
+// The zero value selects the defaults documented on each field.
+type IterativeOptions struct {
+	// Tol is the target relative residual ||b - A*x|| / ||b||. Defaults to DefaultTol.
+	Tol float64
+	// MaxIter caps the number of iterations (operator applications in the
+	// inner loop). Defaults to 10 times the system size.
+	MaxIter int
+	// Restart is the GMRES restart length. Defaults to min(30, system size).
+	Restart int
+	// X0 is the initial guess. Defaults to the zero vector.
+	X0 *data.Vector[float64]
+}
+
+// IterativeResult reports the outcome of an iterative solve
+type IterativeResult struct {
+	X          *data.Vector[float64]
+	Iterations int
+	// Residual is the final residual norm ||b - A*x||
+	Residual  float64
+	Converged bool
+}
+
+// Apply computes y = m*x in float64, so any Matrix can be used as a LinearOperator.
+// It panics with ErrMismatchedDimensions if x or y do not match the matrix shape.
+func (m *Matrix[T]) Apply(x, y *data.Vector[float64]) {
+	if x.Len() != m.Cols || y.Len() != m.Rows {
+		panic(ErrMismatchedDimensions)
+	}
+	for i := 0; i < m.Rows; i++ {
+		var sum float64
+		for j, val := range m.Element[i*m.Cols : (i+1)*m.Cols] {
+			sum += float64(val) * x.At(j)
+		}
+		y.Set(i, sum)
+	}
+}
+
+// Apply computes y = c*x in float64, so any CSR matrix can be used as a LinearOperator.
+// It panics with ErrMismatchedDimensions if x or y do not match the matrix shape.
+func (c *CSR[T]) Apply(x, y *data.Vector[float64]) {
+	if x.Len() != c.Cols || y.Len() != c.Rows {
+		panic(ErrMismatchedDimensions)
+	}
+	for i := 0; i < c.Rows; i++ {
+		var sum float64
+		for k := c.RowPtr[i]; k < c.RowPtr[i+1]; k++ {
+			sum += float64(c.Values[k]) * x.At(c.ColIndex[k])
+		}
+		y.Set(i, sum)
+	}
+}
+
+// ConjugateGradient solves a*x = b for a symmetric positive-definite operator.
+//
+// Returns ErrNotSquare or ErrMismatchedDimensions if a reports its shape
+// through a Dims method and it does not match b. The result is always
+// returned when the inputs are valid; if the tolerance is not reached within
+// MaxIter iterations, or the operator turns out not to be positive definite,
+// the error is ErrNoConvergence.
+func ConjugateGradient(a LinearOperator, b *data.Vector[float64], opts *IterativeOptions) (*IterativeResult, error) {
+	n := b.Len()
+	tol, maxIter, _, x, err := iterativeSetup(a, n, opts)
+	if err != nil {
+		return nil, err
+	}
+	rhs := b.Clone().Element
+
+	r := make([]float64, n)
+	residualInto(a, rhs, x, r)
+	p := make([]float64, n)
+	copy(p, r)
+	ap := &data.Vector[float64]{Element: make([]float64, n)}
+
+	bnorm := norm2(rhs)
+	rs := dot(r, r)
+	result := &IterativeResult{X: &data.Vector[float64]{Element: x}}
+
+	for {
+		result.Residual = math.Sqrt(rs)
+		if result.Residual <= tol*bnorm {
+			result.Converged = true
+			return result, nil
+		}
+		if result.Iterations >= maxIter {
+			return result, ErrNoConvergence
+		}
+
+		a.Apply(&data.Vector[float64]{Element: p}, ap)
+		pAp := dot(p, ap.Element)
+		if pAp <= 0 {
+			return result, ErrNoConvergence
+		}
+		alpha := rs / pAp
+		for i := range x {
+			x[i] += alpha * p[i]
+			r[i] -= alpha * ap.Element[i]
+		}
+		rsNew := dot(r, r)
+		beta := rsNew / rs
+		for i := range p {
+			p[i] = r[i] + beta*p[i]
+		}
+		rs = rsNew
+		result.Iterations++
+	}
+}
+
+// GMRES solves a*x = b for a general square operator using restarted GMRES
+// with modified Gram-Schmidt orthogonalization and Givens rotations.
+//
+// Returns ErrNotSquare or ErrMismatchedDimensions if a reports its shape
+// through a Dims method and it does not match b. The result is always
+// returned when the inputs are valid; if the tolerance is not reached within
+// MaxIter iterations the error is ErrNoConvergence.
+func GMRES(a LinearOperator, b *data.Vector[float64], opts *IterativeOptions) (*IterativeResult, error) {
+	n := b.Len()
+	tol, maxIter, restart, x, err := iterativeSetup(a, n, opts)
+	if err != nil {
+		return nil, err
+	}
+	rhs := b.Clone().Element
+
+	bnorm := norm2(rhs)
+	r := make([]float64, n)
+	w := &data.Vector[float64]{Element: make([]float64, n)}
+	basis := make([][]float64, restart+1)
+	for i := range basis {
+		basis[i] = make([]float64, n)
+	}
+	h := make([][]float64, restart+1)
+	for i := range h {
+		h[i] = make([]float64, restart)
+	}
+	cs := make([]float64, restart)
+	sn := make([]float64, restart)
+	g := make([]float64, restart+1)
+	y := make([]float64, restart)
+	result := &IterativeResult{X: &data.Vector[float64]{Element: x}}
+
+	for {
+		residualInto(a, rhs, x, r)
+		beta := norm2(r)
+		result.Residual = beta
+		if beta <= tol*bnorm {
+			result.Converged = true
+			return result, nil
+		}
+		if result.Iterations >= maxIter {
+			return result, ErrNoConvergence
+		}
+
+		for i := range r {
+			basis[0][i] = r[i] / beta
+		}
+		clear(g)
+		g[0] = beta
+
+		k := 0
+		for j := 0; j < restart && result.Iterations < maxIter; j++ {
+			result.Iterations++
+			a.Apply(&data.Vector[float64]{Element: basis[j]}, w)
+			for i := 0; i <= j; i++ {
+				h[i][j] = dot(w.Element, basis[i])
+				axpy(-h[i][j], basis[i], w.Element)
+			}
+			h[j+1][j] = norm2(w.Element)
+			breakdown := h[j+1][j] == 0
+			if !breakdown {
+				for i := range w.Element {
+					basis[j+1][i] = w.Element[i] / h[j+1][j]
+				}
+			}
+
+			// Apply the previous rotations to the new column, then eliminate h[j+1][j]
+			for i := 0; i < j; i++ {
+				hi, hi1 := h[i][j], h[i+1][j]
+				h[i][j] = cs[i]*hi + sn[i]*hi1
+				h[i+1][j] = -sn[i]*hi + cs[i]*hi1
+			}
+			denom := math.Hypot(h[j][j], h[j+1][j])
+			if denom == 0 {
+				break
+			}
+			cs[j], sn[j] = h[j][j]/denom, h[j+1][j]/denom
+			h[j][j], h[j+1][j] = denom, 0
+			g[j+1] = -sn[j] * g[j]
+			g[j] = cs[j] * g[j]
+			k = j + 1
+
+			if math.Abs(g[j+1]) <= tol*bnorm || breakdown {
+				break
+			}
+		}
+		if k == 0 {
+			return result, ErrNoConvergence
+		}
+
+		// Solve the k x k upper triangular least-squares system and update x
+		for i := k - 1; i >= 0; i-- {
+			y[i] = g[i]
+			for l := i + 1; l < k; l++ {
+				y[i] -= h[i][l] * y[l]
+			}
+			y[i] /= h[i][i]
+		}
+		for i := 0; i < k; i++ {
+			axpy(y[i], basis[i], x)
+		}
+	}
+}
+
+// iterativeSetup validates the operator shape and the options and returns the
+// effective tolerance, iteration limit, restart length and a fresh initial guess.
+// The shape can only be checked for operators that report it, such as Matrix
+// and CSR.
+func iterativeSetup(a LinearOperator, n int, opts *IterativeOptions) (float64, int, int, []float64, error) {
+	if n == 0 {
+		return 0, 0, 0, nil, ErrEmptyMatrix
+	}
+	if op, ok := a.(interface{ Dims() (int, int) }); ok {
+		rows, cols := op.Dims()
+		if rows != cols {
+			return 0, 0, 0, nil, ErrNotSquare
+		}
+		if rows != n {
+			return 0, 0, 0, nil, ErrMismatchedDimensions
+		}
+	}
+	if opts == nil {
+		opts = &IterativeOptions{}
+	}
+	tol := opts.Tol
+	if tol <= 0 {
+		tol = DefaultTol
+	}
+	maxIter := opts.MaxIter
+	if maxIter <= 0 {
+		maxIter = 10 * n
+	}
+	restart := opts.Restart
+	if restart <= 0 {
+		restart = 30
+	}
+	restart = min(restart, n)
+
+	x := make([]float64, n)
+	if opts.X0 != nil {
+		if opts.X0.Len() != n {
+			return 0, 0, 0, nil, ErrMismatchedDimensions
+		}
+		copy(x, opts.X0.Clone().Element)
+	}
+	return tol, maxIter, restart, x, nil
+}
+
+// residualInto computes r = b - a*x
+func residualInto(a LinearOperator, b, x, r []float64) {
+	ax := &data.Vector[float64]{Element: r}
+	a.Apply(&data.Vector[float64]{Element: x}, ax)
+	for i := range r {
+		r[i] = b[i] - r[i]
+	}
+}
+
+// dot returns the inner product of two equal-length slices
+func dot(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+// norm2 returns the Euclidean norm of a slice
+func norm2(a []float64) float64 {
+	return math.Sqrt(dot(a, a))
+}
+
+// axpy computes y += alpha*x
+func axpy(alpha float64, x, y []float64) {
+	for i := range x {
+		y[i] += alpha * x[i]
+	}
+}
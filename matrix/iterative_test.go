@@ -0,0 +1,218 @@
+package matrix_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/matrix"
+)
+
+// diagonal is a matrix-free operator scaling each element
+type diagonal []float64
+
+func (d diagonal) Apply(x, y *data.Vector[float64]) {
+	for i, val := range d {
+		y.Element[i] = val * x.Element[i]
+	}
+}
+
+// laplacian returns the n x n 1-D Laplacian tridiag(-1, 2, -1) in CSR form
+func laplacian(n int) *matrix.CSR[float64] {
+	rowPtr := []int{0}
+	var colIndex []int
+	var values []float64
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			colIndex = append(colIndex, i-1)
+			values = append(values, -1)
+		}
+		colIndex = append(colIndex, i)
+		values = append(values, 2)
+		if i < n-1 {
+			colIndex = append(colIndex, i+1)
+			values = append(values, -1)
+		}
+		rowPtr = append(rowPtr, len(values))
+	}
+	c, _ := matrix.CreateCSR(n, n, rowPtr, colIndex, values)
+	return c
+}
+
+func onesVector(n int) *data.Vector[float64] {
+	v := &data.Vector[float64]{Element: make([]float64, n)}
+	for i := range v.Element {
+		v.Element[i] = 1
+	}
+	return v
+}
+
+// assertSolution checks that a*x matches b to a relative tolerance
+func assertSolution(t *testing.T, a matrix.LinearOperator, x, b *data.Vector[float64], tol float64) {
+	t.Helper()
+	ax := &data.Vector[float64]{Element: make([]float64, b.Len())}
+	a.Apply(x, ax)
+	var diff, norm float64
+	for i := range ax.Element {
+		diff += (ax.Element[i] - b.Element[i]) * (ax.Element[i] - b.Element[i])
+		norm += b.Element[i] * b.Element[i]
+	}
+	if math.Sqrt(diff) > tol*math.Sqrt(norm) {
+		t.Errorf("relative residual %v exceeds %v", math.Sqrt(diff/norm), tol)
+	}
+}
+
+// TestConjugateGradient tests the ConjugateGradient function
+func TestConjugateGradient_SparseLaplacian(t *testing.T) {
+	a := laplacian(200)
+	b := onesVector(200)
+
+	result, err := matrix.ConjugateGradient(a, b, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if !result.Converged {
+		t.Error("expected Converged to be true")
+	}
+	// CG converges in at most n steps in exact arithmetic
+	if result.Iterations == 0 || result.Iterations > 200 {
+		t.Errorf("unexpected iteration count %d", result.Iterations)
+	}
+	assertSolution(t, a, result.X, b, 1e-9)
+}
+
+func TestConjugateGradient_MatchesDirectSolve(t *testing.T) {
+	a := spdMatrix(20)
+	b := onesVector(20)
+
+	result, err := matrix.ConjugateGradient(a, b, &matrix.IterativeOptions{Tol: 1e-12})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	want, _ := matrix.SolveSPD(a, b)
+
+	for i := range want.Element {
+		if math.Abs(result.X.Element[i]-want.Element[i]) > 1e-9 {
+			t.Fatalf("x[%d] = %v, want %v", i, result.X.Element[i], want.Element[i])
+		}
+	}
+}
+
+func TestConjugateGradient_NoConvergence(t *testing.T) {
+	a := laplacian(100)
+	b := onesVector(100)
+
+	result, err := matrix.ConjugateGradient(a, b, &matrix.IterativeOptions{MaxIter: 3})
+
+	if err != matrix.ErrNoConvergence {
+		t.Fatalf("expected ErrNoConvergence, got: %v", err)
+	}
+	if result == nil || result.Converged || result.Iterations != 3 {
+		t.Errorf("expected an unconverged result after 3 iterations, got %+v", result)
+	}
+}
+
+func TestConjugateGradient_InitialGuess(t *testing.T) {
+	a := diagonal{1, 2, 4}
+	b := &data.Vector[float64]{Element: []float64{1, 2, 4}}
+
+	// Starting at the exact solution needs no iterations
+	result, err := matrix.ConjugateGradient(a, b, &matrix.IterativeOptions{X0: onesVector(3)})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Iterations != 0 || result.Residual != 0 {
+		t.Errorf("expected immediate convergence, got %+v", result)
+	}
+
+	_, err = matrix.ConjugateGradient(a, b, &matrix.IterativeOptions{X0: onesVector(2)})
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+// TestGMRES tests the GMRES function
+func TestGMRES_Nonsymmetric(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{4, 1, 0, 0}, {2, 5, 1, 0}, {0, -1, 6, 2}, {1, 0, 3, 7}})
+	b := &data.Vector[float64]{Element: []float64{1, 2, 3, 4}}
+
+	result, err := matrix.GMRES(a, b, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	want, _ := matrix.Solve(a, b)
+
+	for i := range want.Element {
+		if math.Abs(result.X.Element[i]-want.Element[i]) > 1e-9 {
+			t.Fatalf("x[%d] = %v, want %v", i, result.X.Element[i], want.Element[i])
+		}
+	}
+}
+
+func TestGMRES_Restarted(t *testing.T) {
+	a := laplacian(60)
+	b := onesVector(60)
+
+	result, err := matrix.GMRES(a, b, &matrix.IterativeOptions{Restart: 10, MaxIter: 5000})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Iterations <= 10 {
+		t.Errorf("expected restarts to be needed, got %d iterations", result.Iterations)
+	}
+	assertSolution(t, a, result.X, b, 1e-9)
+}
+
+func TestGMRES_NoConvergence(t *testing.T) {
+	a := laplacian(100)
+	b := onesVector(100)
+
+	result, err := matrix.GMRES(a, b, &matrix.IterativeOptions{Restart: 5, MaxIter: 12})
+
+	if err != matrix.ErrNoConvergence {
+		t.Fatalf("expected ErrNoConvergence, got: %v", err)
+	}
+	if result.Converged || result.Iterations != 12 {
+		t.Errorf("expected an unconverged result after 12 iterations, got %+v", result)
+	}
+	if result.Residual <= 0 {
+		t.Errorf("expected a positive residual, got %v", result.Residual)
+	}
+}
+
+func TestIterative_OperatorShape(t *testing.T) {
+	rect, _ := matrix.Zeros[float64](2, 3)
+	square, _ := matrix.Identity[float64](2)
+
+	if _, err := matrix.ConjugateGradient(rect, onesVector(2), nil); err != matrix.ErrNotSquare {
+		t.Errorf("ConjugateGradient(2x3) error = %v, want ErrNotSquare", err)
+	}
+	if _, err := matrix.GMRES(square, onesVector(3), nil); err != matrix.ErrMismatchedDimensions {
+		t.Errorf("GMRES(2x2, len 3) error = %v, want ErrMismatchedDimensions", err)
+	}
+	if _, err := matrix.ConjugateGradient(laplacian(4), onesVector(5), nil); err != matrix.ErrMismatchedDimensions {
+		t.Errorf("ConjugateGradient(CSR 4x4, len 5) error = %v, want ErrMismatchedDimensions", err)
+	}
+}
+
+func TestMatrixApply_MismatchedDimensions(t *testing.T) {
+	a, _ := matrix.Zeros[int](2, 3)
+
+	defer func() {
+		if r := recover(); r != matrix.ErrMismatchedDimensions {
+			t.Errorf("expected panic with ErrMismatchedDimensions, got: %v", r)
+		}
+	}()
+	a.Apply(onesVector(2), onesVector(2))
+}
+
+func BenchmarkConjugateGradient(b *testing.B) {
+	a := laplacian(1000)
+	rhs := onesVector(1000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix.ConjugateGradient(a, rhs, nil)
+	}
+}
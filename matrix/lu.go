@@ -0,0 +1,213 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// epsilon is the float64 machine epsilon used for rank and singularity tolerances
+const epsilon = 0x1p-52
+
+// LU holds the LU factorization with partial pivoting of a square matrix,
+// such that P*A = L*U with L unit lower triangular and U upper triangular.
+type LU struct {
+	lu    *Matrix[float64]
+	pivot []int
+	sign  float64
+}
+
+// DecomposeLU computes the LU factorization of m using partial pivoting.
+// Returns ErrNotSquare for non-square input, ErrNonFinite if an entry is NaN
+// or infinite, and a *SingularMatrixError if a pivot is zero to working
+// precision relative to the largest entry of its row.
+func DecomposeLU[T data.Number](m *Matrix[T]) (*LU, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	a := toFloat64(m)
+	for _, val := range a.Element {
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			return nil, ErrNonFinite
+		}
+	}
+	n := a.Rows
+	pivot := make([]int, n)
+	for i := range pivot {
+		pivot[i] = i
+	}
+	sign := 1.0
+
+	// Pivots are compared against the largest entry of their own original row,
+	// so rows of very different magnitude do not mask each other.
+	rowScale := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for _, val := range a.Element[i*n : (i+1)*n] {
+			rowScale[i] = math.Max(rowScale[i], math.Abs(val))
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		// Find the row with the largest pivot candidate in column k
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(a.Element[i*n+k]) > math.Abs(a.Element[p*n+k]) {
+				p = i
+			}
+		}
+		if !(math.Abs(a.Element[p*n+k]) > float64(n)*epsilon*rowScale[p]) {
+			return nil, &SingularMatrixError{Pivot: k}
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				a.Element[k*n+j], a.Element[p*n+j] = a.Element[p*n+j], a.Element[k*n+j]
+			}
+			pivot[k], pivot[p] = pivot[p], pivot[k]
+			rowScale[k], rowScale[p] = rowScale[p], rowScale[k]
+			sign = -sign
+		}
+
+		for i := k + 1; i < n; i++ {
+			a.Element[i*n+k] /= a.Element[k*n+k]
+			factor := a.Element[i*n+k]
+			for j := k + 1; j < n; j++ {
+				a.Element[i*n+j] -= factor * a.Element[k*n+j]
+			}
+		}
+	}
+	return &LU{lu: a, pivot: pivot, sign: sign}, nil
+}
+
+// L returns the unit lower triangular factor
+func (f *LU) L() *Matrix[float64] {
+	n := f.lu.Rows
+	l := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			l[i*n+j] = f.lu.Element[i*n+j]
+		}
+		l[i*n+i] = 1
+	}
+	return &Matrix[float64]{Rows: n, Cols: n, Element: l}
+}
+
+// U returns the upper triangular factor
+func (f *LU) U() *Matrix[float64] {
+	n := f.lu.Rows
+	u := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			u[i*n+j] = f.lu.Element[i*n+j]
+		}
+	}
+	return &Matrix[float64]{Rows: n, Cols: n, Element: u}
+}
+
+// Pivot returns the row permutation: row i of P*A is row Pivot()[i] of A
+func (f *LU) Pivot() []int {
+	pivot := make([]int, len(f.pivot))
+	copy(pivot, f.pivot)
+	return pivot
+}
+
+// Det returns the determinant of the factorized matrix
+func (f *LU) Det() float64 {
+	n := f.lu.Rows
+	det := f.sign
+	for i := 0; i < n; i++ {
+		det *= f.lu.Element[i*n+i]
+	}
+	return det
+}
+
+// Solve solves A*x = b for x using the factorization.
+// Returns ErrMismatchedDimensions if b does not match the matrix size.
+func (f *LU) Solve(b *data.Vector[float64]) (*data.Vector[float64], error) {
+	n := f.lu.Rows
+	if b.Len() != n {
+		return nil, ErrMismatchedDimensions
+	}
+	x := make([]float64, n)
+	for i, p := range f.pivot {
+		x[i] = b.At(p)
+	}
+	f.solveInPlace(x)
+	return &data.Vector[float64]{Element: x}, nil
+}
+
+// Inverse returns the inverse of the factorized matrix
+func (f *LU) Inverse() *Matrix[float64] {
+	n := f.lu.Rows
+	inv := make([]float64, n*n)
+	col := make([]float64, n)
+	for j := 0; j < n; j++ {
+		for i, p := range f.pivot {
+			if p == j {
+				col[i] = 1
+			} else {
+				col[i] = 0
+			}
+		}
+		f.solveInPlace(col)
+		for i := 0; i < n; i++ {
+			inv[i*n+j] = col[i]
+		}
+	}
+	return &Matrix[float64]{Rows: n, Cols: n, Element: inv}
+}
+
+// solveInPlace performs forward and back substitution on an already permuted right-hand side
+func (f *LU) solveInPlace(x []float64) {
+	n := f.lu.Rows
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= f.lu.Element[i*n+j] * x[j]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= f.lu.Element[i*n+j] * x[j]
+		}
+		x[i] /= f.lu.Element[i*n+i]
+	}
+}
+
+// Det computes the determinant of a square matrix via LU decomposition.
+// A singular matrix has a determinant of zero and is not reported as an error;
+// a matrix with NaN or infinite entries returns ErrNonFinite.
+func Det[T data.Number](m *Matrix[T]) (float64, error) {
+	f, err := DecomposeLU(m)
+	if err != nil {
+		var singular *SingularMatrixError
+		if errors.As(err, &singular) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return f.Det(), nil
+}
+
+// Inverse computes the inverse of a square matrix.
+// Returns a *SingularMatrixError if the matrix is not invertible.
+func Inverse[T data.Number](m *Matrix[T]) (*Matrix[float64], error) {
+	f, err := DecomposeLU(m)
+	if err != nil {
+		return nil, err
+	}
+	return f.Inverse(), nil
+}
+
+// Solve solves the linear system a*x = b for x.
+// Returns a *SingularMatrixError if a is singular and ErrMismatchedDimensions
+// if b does not match the number of rows of a.
+func Solve[T data.Number](a *Matrix[T], b *data.Vector[float64]) (*data.Vector[float64], error) {
+	if a.Rows != b.Len() {
+		return nil, ErrMismatchedDimensions
+	}
+	f, err := DecomposeLU(a)
+	if err != nil {
+		return nil, err
+	}
+	return f.Solve(b)
+}
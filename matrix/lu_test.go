@@ -0,0 +1,233 @@
+package matrix_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/matrix"
+)
+
+// TestDecomposeLU tests the LU factorization
+func TestDecomposeLU_Reconstruct(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{2, 1, 1}, {4, -6, 0}, {-2, 7, 2}})
+
+	f, err := matrix.DecomposeLU(a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	lu, _ := matrix.Mul(f.L(), f.U())
+	for i, p := range f.Pivot() {
+		for j := 0; j < a.Cols; j++ {
+			want, _ := a.At(p, j)
+			got, _ := lu.At(i, j)
+			if math.Abs(got-want) > 1e-12 {
+				t.Errorf("(P*A)[%d][%d] = %v, (L*U)[%d][%d] = %v", i, j, want, i, j, got)
+			}
+		}
+	}
+}
+
+func TestDecomposeLU_NotSquare(t *testing.T) {
+	a, _ := matrix.Zeros[float64](2, 3)
+
+	_, err := matrix.DecomposeLU(a)
+
+	if err != matrix.ErrNotSquare {
+		t.Errorf("expected ErrNotSquare, got: %v", err)
+	}
+}
+
+func TestDecomposeLU_Singular(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]int{{1, 2, 3}, {2, 4, 6}, {1, 0, 1}})
+
+	_, err := matrix.DecomposeLU(a)
+
+	var singular *matrix.SingularMatrixError
+	if !errors.As(err, &singular) {
+		t.Fatalf("expected *SingularMatrixError, got: %v", err)
+	}
+	if singular.Pivot != 2 {
+		t.Errorf("expected pivot index 2, got %d", singular.Pivot)
+	}
+}
+
+func TestDecomposeLU_BadlyScaled(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{1e10, 0}, {0, 1e-7}})
+
+	det, err := matrix.Det(a)
+	if err != nil || math.Abs(det-1e3) > 1e-9 {
+		t.Errorf("Det() = %v, %v, want 1000", det, err)
+	}
+
+	b := &data.Vector[float64]{Element: []float64{1e10, 1e-7}}
+	x, err := matrix.Solve(a, b)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for i, val := range x.Element {
+		if math.Abs(val-1) > 1e-12 {
+			t.Errorf("x[%d] = %v, want 1", i, val)
+		}
+	}
+
+	c, _ := matrix.CreateMatrix([][]float64{{1e20, 0}, {0, 1}})
+	if _, err := matrix.Inverse(c); err != nil {
+		t.Errorf("Inverse(diag(1e20, 1)) error = %v, want nil", err)
+	}
+}
+
+func TestDecomposeLU_NonFinite(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	tests := [][][]float64{
+		{{nan, 1}, {1, 1}},
+		{{inf, 0}, {0, 1}},
+		{{2, nan}, {1, 3}},
+	}
+
+	for _, rows := range tests {
+		a, _ := matrix.CreateMatrix(rows)
+
+		if _, err := matrix.DecomposeLU(a); err != matrix.ErrNonFinite {
+			t.Errorf("DecomposeLU(%v) error = %v, want ErrNonFinite", rows, err)
+		}
+		if det, err := matrix.Det(a); err != matrix.ErrNonFinite {
+			t.Errorf("Det(%v) = %v, %v, want ErrNonFinite", rows, det, err)
+		}
+		if _, err := matrix.Inverse(a); err != matrix.ErrNonFinite {
+			t.Errorf("Inverse(%v) error = %v, want ErrNonFinite", rows, err)
+		}
+		if _, err := matrix.Solve(a, &data.Vector[float64]{Element: []float64{1, 1}}); err != matrix.ErrNonFinite {
+			t.Errorf("Solve(%v) error = %v, want ErrNonFinite", rows, err)
+		}
+	}
+}
+
+// TestDet tests the Det function
+func TestDet(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     [][]int
+		expected float64
+	}{
+		{"1x1", [][]int{{5}}, 5},
+		{"2x2", [][]int{{4, 3}, {6, 3}}, -6},
+		{"3x3", [][]int{{2, -3, 1}, {2, 0, -1}, {1, 4, 5}}, 49},
+		{"Needs pivoting", [][]int{{0, 1}, {1, 0}}, -1},
+		{"Singular", [][]int{{1, 2}, {2, 4}}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := matrix.CreateMatrix(tt.rows)
+			got, err := matrix.Det(m)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if math.Abs(got-tt.expected) > 1e-10 {
+				t.Errorf("Det() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestInverse tests the Inverse function
+func TestInverse_Success(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{4, 7}, {2, 6}})
+
+	inv, err := matrix.Inverse(a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	product, _ := matrix.Mul(a, inv)
+	id, _ := matrix.Identity[float64](2)
+	for i := range product.Element {
+		if math.Abs(product.Element[i]-id.Element[i]) > 1e-12 {
+			t.Errorf("A*inv(A) = %v, want identity", product.Element)
+			break
+		}
+	}
+}
+
+func TestInverse_Singular(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{1, 2}, {2, 4}})
+
+	_, err := matrix.Inverse(a)
+
+	var singular *matrix.SingularMatrixError
+	if !errors.As(err, &singular) {
+		t.Errorf("expected *SingularMatrixError, got: %v", err)
+	}
+}
+
+// TestSolve tests the Solve function
+func TestSolve_Success(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]int{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}})
+	b := &data.Vector[float64]{Element: []float64{8, -11, -3}}
+
+	x, err := matrix.Solve(a, b)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []float64{2, 3, -1}
+	for i, val := range x.Element {
+		if math.Abs(val-expected[i]) > 1e-12 {
+			t.Errorf("expected %f at index %d, got %f", expected[i], i, val)
+		}
+	}
+}
+
+func TestSolve_MismatchedDimensions(t *testing.T) {
+	a, _ := matrix.Identity[float64](3)
+	b := &data.Vector[float64]{Element: []float64{1, 2}}
+
+	_, err := matrix.Solve(a, b)
+
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+func TestSolve_Singular(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{1, 1}, {1, 1}})
+	b := &data.Vector[float64]{Element: []float64{1, 2}}
+
+	x, err := matrix.Solve(a, b)
+
+	var singular *matrix.SingularMatrixError
+	if !errors.As(err, &singular) {
+		t.Errorf("expected *SingularMatrixError, got: %v", err)
+	}
+	if x != nil {
+		t.Errorf("expected nil solution, got %v", x.Element)
+	}
+}
+
+// nonsingularMatrix returns a well-conditioned, diagonally dominant n x n matrix
+func nonsingularMatrix(n int) *matrix.Matrix[float64] {
+	a := filledMatrix(n, n, 1)
+	for i := 0; i < n; i++ {
+		a.Element[i*n+i] += float64(n)
+	}
+	return a
+}
+
+func BenchmarkSolve100(b *testing.B) {
+	a := nonsingularMatrix(100)
+	rhs := &data.Vector[float64]{Element: make([]float64, 100)}
+	for i := range rhs.Element {
+		rhs.Element[i] = float64(i)
+	}
+	if _, err := matrix.Solve(a, rhs); err != nil {
+		b.Fatalf("expected no error, got: %v", err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix.Solve(a, rhs)
+	}
+}
@@ -0,0 +1,201 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// Matrix represents a generic dense matrix stored in row-major order
+type Matrix[T data.Number] struct {
+	Rows    int
+	Cols    int
+	Element []T
+}
+
+// CreateMatrix creates a new matrix from a slice of rows.
+// Returns ErrEmptyMatrix if there are no elements and ErrMismatchedDimensions
+// if the rows do not all have the same length.
+func CreateMatrix[T data.Number](rows [][]T) (*Matrix[T], error) {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return nil, ErrEmptyMatrix
+	}
+	cols := len(rows[0])
+	element := make([]T, 0, len(rows)*cols)
+	for _, row := range rows {
+		if len(row) != cols {
+			return nil, ErrMismatchedDimensions
+		}
+		element = append(element, row...)
+	}
+	return &Matrix[T]{Rows: len(rows), Cols: cols, Element: element}, nil
+}
+
+// Zeros creates a rows x cols matrix filled with zeros.
+// Returns ErrEmptyMatrix if either dimension is not positive.
+func Zeros[T data.Number](rows, cols int) (*Matrix[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, ErrEmptyMatrix
+	}
+	return &Matrix[T]{Rows: rows, Cols: cols, Element: make([]T, rows*cols)}, nil
+}
+
+// Identity creates an n x n identity matrix.
+// Returns ErrEmptyMatrix if n is not positive.
+func Identity[T data.Number](n int) (*Matrix[T], error) {
+	m, err := Zeros[T](n, n)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		m.Element[i*n+i] = 1
+	}
+	return m, nil
+}
+
+// FromColumns creates a matrix whose columns are the given vectors.
+// All vectors must have the same length.
+func FromColumns[T data.Number](cols ...*data.Vector[T]) (*Matrix[T], error) {
+	if len(cols) == 0 || cols[0].Len() == 0 {
+		return nil, ErrEmptyMatrix
+	}
+	rows := cols[0].Len()
+	for _, c := range cols {
+		if c.Len() != rows {
+			return nil, ErrMismatchedDimensions
+		}
+	}
+	m := &Matrix[T]{Rows: rows, Cols: len(cols), Element: make([]T, rows*len(cols))}
+	for j, c := range cols {
+		for i, val := range c.All() {
+			m.Element[i*m.Cols+j] = val
+		}
+	}
+	return m, nil
+}
+
+// Dims returns the number of rows and columns of the matrix
+func (m *Matrix[T]) Dims() (int, int) {
+	return m.Rows, m.Cols
+}
+
+// IsSquare reports whether the matrix has as many rows as columns
+func (m *Matrix[T]) IsSquare() bool {
+	return m.Rows == m.Cols
+}
+
+// At returns the element at row i and column j
+func (m *Matrix[T]) At(i, j int) (T, error) {
+	if i < 0 || i >= m.Rows || j < 0 || j >= m.Cols {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+	return m.Element[i*m.Cols+j], nil
+}
+
+// Set sets the element at row i and column j
+func (m *Matrix[T]) Set(i, j int, val T) error {
+	if i < 0 || i >= m.Rows || j < 0 || j >= m.Cols {
+		return ErrIndexOutOfRange
+	}
+	m.Element[i*m.Cols+j] = val
+	return nil
+}
+
+// Row returns a copy of row i as a vector
+func (m *Matrix[T]) Row(i int) (*data.Vector[T], error) {
+	if i < 0 || i >= m.Rows {
+		return nil, ErrIndexOutOfRange
+	}
+	row := make([]T, m.Cols)
+	copy(row, m.Element[i*m.Cols:(i+1)*m.Cols])
+	return &data.Vector[T]{Element: row}, nil
+}
+
+// Col returns a copy of column j as a vector
+func (m *Matrix[T]) Col(j int) (*data.Vector[T], error) {
+	if j < 0 || j >= m.Cols {
+		return nil, ErrIndexOutOfRange
+	}
+	col := make([]T, m.Rows)
+	for i := range col {
+		col[i] = m.Element[i*m.Cols+j]
+	}
+	return &data.Vector[T]{Element: col}, nil
+}
+
+// RowView returns row i as a vector view sharing storage with the matrix
+func (m *Matrix[T]) RowView(i int) (*data.Vector[T], error) {
+	if i < 0 || i >= m.Rows {
+		return nil, ErrIndexOutOfRange
+	}
+	row := m.Element[i*m.Cols : (i+1)*m.Cols : (i+1)*m.Cols]
+	return &data.Vector[T]{Element: row}, nil
+}
+
+// ColView returns column j as a strided vector view sharing storage with the matrix
+func (m *Matrix[T]) ColView(j int) (*data.Vector[T], error) {
+	if j < 0 || j >= m.Cols {
+		return nil, ErrIndexOutOfRange
+	}
+	end := (m.Rows-1)*m.Cols + j + 1
+	return &data.Vector[T]{Element: m.Element[j:end:end], Stride: m.Cols}, nil
+}
+
+// Clone returns a copy of the current matrix
+func (m *Matrix[T]) Clone() *Matrix[T] {
+	cloned := make([]T, len(m.Element))
+	copy(cloned, m.Element)
+	return &Matrix[T]{Rows: m.Rows, Cols: m.Cols, Element: cloned}
+}
+
+// Transpose returns a new matrix with rows and columns swapped
+func (m *Matrix[T]) Transpose() *Matrix[T] {
+	t := make([]T, len(m.Element))
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			t[j*m.Rows+i] = m.Element[i*m.Cols+j]
+		}
+	}
+	return &Matrix[T]{Rows: m.Cols, Cols: m.Rows, Element: t}
+}
+
+// EqualMatrices checks if both matrices have the same shape and elements.
+func EqualMatrices[T data.Number](a, b *Matrix[T]) bool {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		return false
+	}
+	for i := range a.Element {
+		if a.Element[i] != b.Element[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// toFloat64 returns a float64 copy of the matrix, converting each element
+// with float64(val) like data.Vector.Normalize does.
+func toFloat64[T data.Number](m *Matrix[T]) *Matrix[float64] {
+	converted := make([]float64, len(m.Element))
+	for i, val := range m.Element {
+		converted[i] = float64(val)
+	}
+	return &Matrix[float64]{Rows: m.Rows, Cols: m.Cols, Element: converted}
+}
+
+// normalize divides a in place by its largest absolute element and returns
+// that factor, so that sums of squares of the entries neither overflow nor
+// underflow. A zero or non-finite matrix is left unchanged and 1 is returned.
+func normalize(a *Matrix[float64]) float64 {
+	var scale float64
+	for _, val := range a.Element {
+		scale = math.Max(scale, math.Abs(val))
+	}
+	if scale == 0 || math.IsInf(scale, 0) || math.IsNaN(scale) {
+		return 1
+	}
+	for i := range a.Element {
+		a.Element[i] /= scale
+	}
+	return scale
+}
@@ -0,0 +1,251 @@
+package matrix_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/matrix"
+)
+
+// TestCreateMatrix tests the CreateMatrix function
+func TestCreateMatrix_Success(t *testing.T) {
+	m, err := matrix.CreateMatrix([][]int{{1, 2, 3}, {4, 5, 6}})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	rows, cols := m.Dims()
+	if rows != 2 || cols != 3 {
+		t.Errorf("expected 2x3 matrix, got: %dx%d", rows, cols)
+	}
+
+	expected := []int{1, 2, 3, 4, 5, 6}
+	if !reflect.DeepEqual(m.Element, expected) {
+		t.Errorf("expected backing slice %v, got %v", expected, m.Element)
+	}
+}
+
+func TestCreateMatrix_Empty(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]int
+	}{
+		{"No rows", [][]int{}},
+		{"Empty row", [][]int{{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := matrix.CreateMatrix(tt.rows)
+			if err != matrix.ErrEmptyMatrix {
+				t.Errorf("expected ErrEmptyMatrix, got: %v", err)
+			}
+			if m != nil {
+				t.Errorf("expected nil matrix, got: %+v", m)
+			}
+		})
+	}
+}
+
+func TestCreateMatrix_RaggedRows(t *testing.T) {
+	_, err := matrix.CreateMatrix([][]int{{1, 2}, {3}})
+
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+func TestCreateMatrix_CopiesRows(t *testing.T) {
+	rows := [][]int{{1, 2}, {3, 4}}
+	m, _ := matrix.CreateMatrix(rows)
+
+	rows[0][0] = 99
+	if v, _ := m.At(0, 0); v != 1 {
+		t.Errorf("expected matrix to own its data, got %d at (0, 0)", v)
+	}
+}
+
+// TestZeros tests the Zeros function
+func TestZeros(t *testing.T) {
+	m, err := matrix.Zeros[float64](2, 3)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(m.Element, make([]float64, 6)) {
+		t.Errorf("expected all zeros, got %v", m.Element)
+	}
+
+	if _, err := matrix.Zeros[int](0, 3); err != matrix.ErrEmptyMatrix {
+		t.Errorf("expected ErrEmptyMatrix, got: %v", err)
+	}
+}
+
+// TestIdentity tests the Identity function
+func TestIdentity(t *testing.T) {
+	m, err := matrix.Identity[int](3)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []int{1, 0, 0, 0, 1, 0, 0, 0, 1}
+	if !reflect.DeepEqual(m.Element, expected) {
+		t.Errorf("expected %v, got %v", expected, m.Element)
+	}
+
+	if _, err := matrix.Identity[int](-1); err != matrix.ErrEmptyMatrix {
+		t.Errorf("expected ErrEmptyMatrix, got: %v", err)
+	}
+}
+
+// TestFromColumns tests the FromColumns function
+func TestFromColumns_Success(t *testing.T) {
+	c1 := &data.Vector[int]{Element: []int{1, 2, 3}}
+	c2 := &data.Vector[int]{Element: []int{4, 5, 6}}
+
+	m, err := matrix.FromColumns(c1, c2)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected, _ := matrix.CreateMatrix([][]int{{1, 4}, {2, 5}, {3, 6}})
+	if !matrix.EqualMatrices(m, expected) {
+		t.Errorf("expected %v, got %v", expected.Element, m.Element)
+	}
+}
+
+func TestFromColumns_MismatchedLengths(t *testing.T) {
+	c1 := &data.Vector[int]{Element: []int{1, 2, 3}}
+	c2 := &data.Vector[int]{Element: []int{4, 5}}
+
+	_, err := matrix.FromColumns(c1, c2)
+
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+// TestAtSet tests element access
+func TestAtSet(t *testing.T) {
+	m, _ := matrix.Zeros[int](2, 2)
+
+	if err := m.Set(1, 0, 7); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	got, err := m.At(1, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got != 7 {
+		t.Errorf("expected 7, got %d", got)
+	}
+
+	if _, err := m.At(2, 0); err != matrix.ErrIndexOutOfRange {
+		t.Errorf("expected ErrIndexOutOfRange, got: %v", err)
+	}
+	if err := m.Set(0, -1, 1); err != matrix.ErrIndexOutOfRange {
+		t.Errorf("expected ErrIndexOutOfRange, got: %v", err)
+	}
+}
+
+// TestRowCol tests row and column extraction
+func TestRowCol(t *testing.T) {
+	m, _ := matrix.CreateMatrix([][]int{{1, 2, 3}, {4, 5, 6}})
+
+	row, err := m.Row(1)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(row.Element, []int{4, 5, 6}) {
+		t.Errorf("Row(1) = %v, want [4 5 6]", row.Element)
+	}
+
+	col, err := m.Col(2)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(col.Element, []int{3, 6}) {
+		t.Errorf("Col(2) = %v, want [3 6]", col.Element)
+	}
+
+	// Extracted vectors must not share storage with the matrix
+	row.Element[0] = 99
+	if v, _ := m.At(1, 0); v != 4 {
+		t.Errorf("Row() shares storage with the matrix")
+	}
+
+	if _, err := m.Row(2); err != matrix.ErrIndexOutOfRange {
+		t.Errorf("expected ErrIndexOutOfRange, got: %v", err)
+	}
+	if _, err := m.Col(3); err != matrix.ErrIndexOutOfRange {
+		t.Errorf("expected ErrIndexOutOfRange, got: %v", err)
+	}
+}
+
+func TestCloneTranspose(t *testing.T) {
+	m, _ := matrix.CreateMatrix([][]int{{1, 2, 3}, {4, 5, 6}})
+
+	cloned := m.Clone()
+	cloned.Element[0] = 99
+	if m.Element[0] == 99 {
+		t.Errorf("Clone() created shallow copy instead of deep copy")
+	}
+
+	transposed := m.Transpose()
+	expected, _ := matrix.CreateMatrix([][]int{{1, 4}, {2, 5}, {3, 6}})
+	if !matrix.EqualMatrices(transposed, expected) {
+		t.Errorf("Transpose() = %v, want %v", transposed.Element, expected.Element)
+	}
+}
+
+func TestEqualMatrices(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]int{{1, 2}, {3, 4}})
+	b, _ := matrix.CreateMatrix([][]int{{1, 2}, {3, 4}})
+	c, _ := matrix.CreateMatrix([][]int{{1, 2, 3, 4}})
+
+	if !matrix.EqualMatrices(a, b) {
+		t.Error("expected true for equal matrices")
+	}
+	if matrix.EqualMatrices(a, c) {
+		t.Error("expected false for matrices with different shapes")
+	}
+}
+
+func TestRowColView(t *testing.T) {
+	m, _ := matrix.CreateMatrix([][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+
+	col, err := m.ColView(1)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(col.Clone().Element, []int{2, 5, 8}) {
+		t.Errorf("ColView(1) = %v, want [2 5 8]", col.Clone().Element)
+	}
+
+	row, err := m.RowView(2)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Views share storage with the matrix in both directions
+	col.Set(2, 80)
+	if v, _ := m.At(2, 1); v != 80 {
+		t.Errorf("write through ColView not visible in matrix, got %d", v)
+	}
+	if row.At(1) != 80 {
+		t.Errorf("write through ColView not visible in RowView, got %d", row.At(1))
+	}
+
+	if _, err := m.ColView(3); err != matrix.ErrIndexOutOfRange {
+		t.Errorf("expected ErrIndexOutOfRange, got: %v", err)
+	}
+	if _, err := m.RowView(-1); err != matrix.ErrIndexOutOfRange {
+		t.Errorf("expected ErrIndexOutOfRange, got: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package matrix
+
+import "github.com/wendersoon/gomathx/data"
+
+// blockSize is the tile edge used by the blocked multiplication kernel.
+// 64x64 float64 tiles of A, B and C fit comfortably in a typical L2 cache.
+const blockSize = 64
+
+// Mul computes the matrix product a * b.
+// Returns ErrMismatchedDimensions if a.Cols != b.Rows.
+//
+// The product is computed with a cache-blocked i-k-j kernel. Each output
+// element still accumulates its terms in ascending k order, so the result
+// matches a serial DotProduct of the corresponding row of a and column of b.
+// With parallel execution enabled DotProduct sums in chunks and may differ in
+// the last bits.
+func Mul[T data.Number](a, b *Matrix[T]) (*Matrix[T], error) {
+	if a.Cols != b.Rows {
+		return nil, ErrMismatchedDimensions
+	}
+	n, m, p := a.Rows, a.Cols, b.Cols
+	c := make([]T, n*p)
+
+	for ii := 0; ii < n; ii += blockSize {
+		iEnd := min(ii+blockSize, n)
+		for kk := 0; kk < m; kk += blockSize {
+			kEnd := min(kk+blockSize, m)
+			for jj := 0; jj < p; jj += blockSize {
+				jEnd := min(jj+blockSize, p)
+				for i := ii; i < iEnd; i++ {
+					cRow := c[i*p : i*p+p]
+					for k := kk; k < kEnd; k++ {
+						aik := a.Element[i*m+k]
+						bRow := b.Element[k*p : k*p+p]
+						for j := jj; j < jEnd; j++ {
+							cRow[j] += aik * bRow[j]
+						}
+					}
+				}
+			}
+		}
+	}
+	return &Matrix[T]{Rows: n, Cols: p, Element: c}, nil
+}
+
+// MulVec computes the matrix-vector product m * v.
+// Each element of the result is the dot product of a row of m with v,
+// accumulated in order as a serial DotProduct does.
+// Returns ErrMismatchedDimensions if m.Cols != v.Len().
+func MulVec[T data.Number](m *Matrix[T], v *data.Vector[T]) (*data.Vector[T], error) {
+	if m.Cols != v.Len() {
+		return nil, ErrMismatchedDimensions
+	}
+	result := make([]T, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		row := m.Element[i*m.Cols : (i+1)*m.Cols]
+		var sum T
+		for j, val := range row {
+			sum += val * v.At(j)
+		}
+		result[i] = sum
+	}
+	return &data.Vector[T]{Element: result}, nil
+}
@@ -0,0 +1,175 @@
+package matrix_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/matrix"
+	"github.com/wendersoon/gomathx/vector"
+)
+
+// TestMul tests the Mul function
+func TestMul_Success(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]int{{1, 2, 3}, {4, 5, 6}})
+	b, _ := matrix.CreateMatrix([][]int{{7, 8}, {9, 10}, {11, 12}})
+
+	result, err := matrix.Mul(a, b)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected, _ := matrix.CreateMatrix([][]int{{58, 64}, {139, 154}})
+	if !matrix.EqualMatrices(result, expected) {
+		t.Errorf("expected %v, got %v", expected.Element, result.Element)
+	}
+}
+
+func TestMul_Identity(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{1.5, -2}, {0.25, 4}})
+	id, _ := matrix.Identity[float64](2)
+
+	result, err := matrix.Mul(a, id)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !matrix.EqualMatrices(result, a) {
+		t.Errorf("expected %v, got %v", a.Element, result.Element)
+	}
+}
+
+func TestMul_MismatchedDimensions(t *testing.T) {
+	a, _ := matrix.Zeros[int](2, 3)
+	b, _ := matrix.Zeros[int](2, 3)
+
+	_, err := matrix.Mul(a, b)
+
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+func TestMul_MatchesDotProduct(t *testing.T) {
+	// Use sizes that are not multiples of the block size so partial tiles are covered
+	a := filledMatrix(70, 131, 1)
+	b := filledMatrix(131, 67, 2)
+
+	result, err := matrix.Mul(a, b)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	for i := 0; i < a.Rows; i++ {
+		row, _ := a.Row(i)
+		for j := 0; j < b.Cols; j++ {
+			col, _ := b.Col(j)
+			want, _ := vector.DotProduct(row, col)
+			got, _ := result.At(i, j)
+			if got != want {
+				t.Fatalf("element (%d, %d) = %v, want %v", i, j, got, want)
+			}
+		}
+	}
+}
+
+// TestMulVec tests the MulVec function
+func TestMulVec_Success(t *testing.T) {
+	m, _ := matrix.CreateMatrix([][]int{{1, 2, 3}, {4, 5, 6}})
+	v := &data.Vector[int]{Element: []int{1, 0, -1}}
+
+	result, err := matrix.MulVec(m, v)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(result.Element, []int{-2, -2}) {
+		t.Errorf("expected [-2 -2], got %v", result.Element)
+	}
+}
+
+func TestMulVec_MismatchedDimensions(t *testing.T) {
+	m, _ := matrix.Zeros[int](2, 3)
+	v := &data.Vector[int]{Element: []int{1, 2}}
+
+	_, err := matrix.MulVec(m, v)
+
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+func TestMulVec_Float(t *testing.T) {
+	m, _ := matrix.CreateMatrix([][]float64{{0.5, 1.5}, {2, -1}})
+	v := &data.Vector[float64]{Element: []float64{2, 4}}
+
+	result, _ := matrix.MulVec(m, v)
+
+	expected := []float64{7, 0}
+	for i, val := range result.Element {
+		if math.Abs(val-expected[i]) > 1e-12 {
+			t.Errorf("expected %f at index %d, got %f", expected[i], i, val)
+		}
+	}
+}
+
+func TestMulVec_StridedView(t *testing.T) {
+	m, _ := matrix.CreateMatrix([][]int{{1, 2}, {3, 4}})
+	source, _ := matrix.CreateMatrix([][]int{{1, 9, 9}, {2, 9, 9}})
+
+	// Column 0 of source is the strided view [1, 2]
+	col, _ := source.ColView(0)
+	result, err := matrix.MulVec(m, col)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(result.Element, []int{5, 11}) {
+		t.Errorf("expected [5 11], got %v", result.Element)
+	}
+}
+
+// filledMatrix returns a rows x cols matrix with deterministic non-trivial values
+func filledMatrix(rows, cols int, seed float64) *matrix.Matrix[float64] {
+	m, _ := matrix.Zeros[float64](rows, cols)
+	for i := range m.Element {
+		m.Element[i] = math.Sin(float64(i)*0.37 + seed)
+	}
+	return m
+}
+
+// Benchmark tests
+func BenchmarkMul100(b *testing.B) {
+	x := filledMatrix(100, 100, 1)
+	y := filledMatrix(100, 100, 2)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix.Mul(x, y)
+	}
+}
+
+func BenchmarkMul1000(b *testing.B) {
+	x := filledMatrix(1000, 1000, 1)
+	y := filledMatrix(1000, 1000, 2)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix.Mul(x, y)
+	}
+}
+
+func BenchmarkMulVec(b *testing.B) {
+	m := filledMatrix(1000, 1000, 1)
+	v := &data.Vector[float64]{Element: make([]float64, 1000)}
+	for i := range v.Element {
+		v.Element[i] = float64(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix.MulVec(m, v)
+	}
+}
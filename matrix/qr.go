@@ -0,0 +1,164 @@
+package matrix
+
+import (
+	"math"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// QR holds the Householder QR factorization of an m x n matrix with m >= n,
+// such that A = Q*R with Q having orthonormal columns and R upper triangular.
+type QR struct {
+	qr    *Matrix[float64]
+	rdiag []float64
+}
+
+// DecomposeQR computes the QR factorization of m using Householder reflections.
+// Returns ErrMismatchedDimensions if m has fewer rows than columns.
+func DecomposeQR[T data.Number](m *Matrix[T]) (*QR, error) {
+	if m.Rows < m.Cols {
+		return nil, ErrMismatchedDimensions
+	}
+	a := toFloat64(m)
+	rows, cols := a.Rows, a.Cols
+	rdiag := make([]float64, cols)
+
+	for k := 0; k < cols; k++ {
+		var nrm float64
+		for i := k; i < rows; i++ {
+			nrm = math.Hypot(nrm, a.Element[i*cols+k])
+		}
+		if nrm != 0 {
+			// Choose the reflection sign that avoids cancellation
+			if a.Element[k*cols+k] < 0 {
+				nrm = -nrm
+			}
+			for i := k; i < rows; i++ {
+				a.Element[i*cols+k] /= nrm
+			}
+			a.Element[k*cols+k] += 1
+
+			for j := k + 1; j < cols; j++ {
+				var s float64
+				for i := k; i < rows; i++ {
+					s += a.Element[i*cols+k] * a.Element[i*cols+j]
+				}
+				s = -s / a.Element[k*cols+k]
+				for i := k; i < rows; i++ {
+					a.Element[i*cols+j] += s * a.Element[i*cols+k]
+				}
+			}
+		}
+		rdiag[k] = -nrm
+	}
+	return &QR{qr: a, rdiag: rdiag}, nil
+}
+
+// FullRank reports whether R has no diagonal entry that is zero to working
+// precision. A matrix with NaN or infinite entries is never full rank.
+func (f *QR) FullRank() bool {
+	var scale float64
+	for _, d := range f.rdiag {
+		scale = math.Max(scale, math.Abs(d))
+	}
+	tol := float64(max(f.qr.Rows, f.qr.Cols)) * epsilon * scale
+	for _, d := range f.rdiag {
+		if !(math.Abs(d) > tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Q returns the m x n factor with orthonormal columns
+func (f *QR) Q() *Matrix[float64] {
+	rows, cols := f.qr.Rows, f.qr.Cols
+	q := make([]float64, rows*cols)
+	for k := cols - 1; k >= 0; k-- {
+		q[k*cols+k] = 1
+		for j := k; j < cols; j++ {
+			if f.qr.Element[k*cols+k] == 0 {
+				continue
+			}
+			var s float64
+			for i := k; i < rows; i++ {
+				s += f.qr.Element[i*cols+k] * q[i*cols+j]
+			}
+			s = -s / f.qr.Element[k*cols+k]
+			for i := k; i < rows; i++ {
+				q[i*cols+j] += s * f.qr.Element[i*cols+k]
+			}
+		}
+	}
+	return &Matrix[float64]{Rows: rows, Cols: cols, Element: q}
+}
+
+// R returns the n x n upper triangular factor
+func (f *QR) R() *Matrix[float64] {
+	cols := f.qr.Cols
+	r := make([]float64, cols*cols)
+	for i := 0; i < cols; i++ {
+		r[i*cols+i] = f.rdiag[i]
+		for j := i + 1; j < cols; j++ {
+			r[i*cols+j] = f.qr.Element[i*cols+j]
+		}
+	}
+	return &Matrix[float64]{Rows: cols, Cols: cols, Element: r}
+}
+
+// Solve returns the x minimizing ||A*x - b|| together with the residual norm.
+// Returns ErrMismatchedDimensions if b does not match the number of rows and
+// ErrRankDeficient if A does not have full column rank.
+func (f *QR) Solve(b *data.Vector[float64]) (*data.Vector[float64], float64, error) {
+	rows, cols := f.qr.Rows, f.qr.Cols
+	if b.Len() != rows {
+		return nil, 0, ErrMismatchedDimensions
+	}
+	if !f.FullRank() {
+		return nil, 0, ErrRankDeficient
+	}
+
+	// Compute Q^T * b by applying the Householder reflections in order
+	y := b.Clone().Element
+	for k := 0; k < cols; k++ {
+		var s float64
+		for i := k; i < rows; i++ {
+			s += f.qr.Element[i*cols+k] * y[i]
+		}
+		s = -s / f.qr.Element[k*cols+k]
+		for i := k; i < rows; i++ {
+			y[i] += s * f.qr.Element[i*cols+k]
+		}
+	}
+
+	// The components of Q^T * b outside the range of A form the residual
+	var residual float64
+	for i := cols; i < rows; i++ {
+		residual = math.Hypot(residual, y[i])
+	}
+
+	// Back substitution with R
+	x := make([]float64, cols)
+	copy(x, y[:cols])
+	for k := cols - 1; k >= 0; k-- {
+		x[k] /= f.rdiag[k]
+		for i := 0; i < k; i++ {
+			x[i] -= x[k] * f.qr.Element[i*cols+k]
+		}
+	}
+	return &data.Vector[float64]{Element: x}, residual, nil
+}
+
+// LeastSquares solves the overdetermined system a*x ≈ b in the least-squares sense.
+// Returns the coefficient vector and the residual norm ||a*x - b||.
+// Returns ErrRankDeficient if a does not have full column rank.
+func LeastSquares[T data.Number](a *Matrix[T], b *data.Vector[float64]) (*data.Vector[float64], float64, error) {
+	if a.Rows != b.Len() {
+		return nil, 0, ErrMismatchedDimensions
+	}
+	f, err := DecomposeQR(a)
+	if err != nil {
+		return nil, 0, err
+	}
+	return f.Solve(b)
+}
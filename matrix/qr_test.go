@@ -0,0 +1,139 @@
+package matrix_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/matrix"
+)
+
+// TestDecomposeQR tests the QR factorization
+func TestDecomposeQR_Reconstruct(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}, {1, 1, 1}})
+
+	f, err := matrix.DecomposeQR(a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	q, r := f.Q(), f.R()
+	qr, _ := matrix.Mul(q, r)
+	for i := range a.Element {
+		if math.Abs(qr.Element[i]-a.Element[i]) > 1e-10 {
+			t.Fatalf("Q*R = %v, want %v", qr.Element, a.Element)
+		}
+	}
+
+	// Q must have orthonormal columns
+	qtq, _ := matrix.Mul(q.Transpose(), q)
+	id, _ := matrix.Identity[float64](3)
+	for i := range id.Element {
+		if math.Abs(qtq.Element[i]-id.Element[i]) > 1e-12 {
+			t.Fatalf("Q^T*Q = %v, want identity", qtq.Element)
+		}
+	}
+
+	// R must be upper triangular
+	for i := 0; i < r.Rows; i++ {
+		for j := 0; j < i; j++ {
+			if v, _ := r.At(i, j); v != 0 {
+				t.Errorf("R[%d][%d] = %v, want 0", i, j, v)
+			}
+		}
+	}
+}
+
+func TestDecomposeQR_Wide(t *testing.T) {
+	a, _ := matrix.Zeros[float64](2, 3)
+
+	_, err := matrix.DecomposeQR(a)
+
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
+
+// TestLeastSquares tests the LeastSquares function
+func TestLeastSquares_ExactFit(t *testing.T) {
+	// y = 1 + 2x sampled without noise
+	a, _ := matrix.CreateMatrix([][]int{{1, 0}, {1, 1}, {1, 2}, {1, 3}})
+	b := &data.Vector[float64]{Element: []float64{1, 3, 5, 7}}
+
+	x, residual, err := matrix.LeastSquares(a, b)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []float64{1, 2}
+	for i, val := range x.Element {
+		if math.Abs(val-expected[i]) > 1e-12 {
+			t.Errorf("expected %f at index %d, got %f", expected[i], i, val)
+		}
+	}
+	if residual > 1e-12 {
+		t.Errorf("expected zero residual, got %v", residual)
+	}
+}
+
+func TestLeastSquares_Overdetermined(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{1, 0}, {1, 1}, {1, 2}})
+	b := &data.Vector[float64]{Element: []float64{1, 2, 2}}
+
+	x, residual, err := matrix.LeastSquares(a, b)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Normal equations give x = [7/6, 1/2]
+	expected := []float64{7.0 / 6.0, 0.5}
+	for i, val := range x.Element {
+		if math.Abs(val-expected[i]) > 1e-12 {
+			t.Errorf("expected %f at index %d, got %f", expected[i], i, val)
+		}
+	}
+
+	fitted, _ := matrix.MulVec(a, x)
+	var want float64
+	for i, val := range fitted.Element {
+		want += (val - b.Element[i]) * (val - b.Element[i])
+	}
+	if math.Abs(residual-math.Sqrt(want)) > 1e-12 {
+		t.Errorf("residual = %v, want %v", residual, math.Sqrt(want))
+	}
+}
+
+func TestLeastSquares_RankDeficient(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{1, 2}, {2, 4}, {3, 6}})
+	b := &data.Vector[float64]{Element: []float64{1, 2, 3}}
+
+	_, _, err := matrix.LeastSquares(a, b)
+
+	if err != matrix.ErrRankDeficient {
+		t.Errorf("expected ErrRankDeficient, got: %v", err)
+	}
+}
+
+func TestLeastSquares_NonFinite(t *testing.T) {
+	b := &data.Vector[float64]{Element: []float64{1, 2, 3}}
+	for _, bad := range []float64{math.NaN(), math.Inf(1)} {
+		a, _ := matrix.CreateMatrix([][]float64{{1, 0}, {0, bad}, {1, 1}})
+
+		x, _, err := matrix.LeastSquares(a, b)
+
+		if err != matrix.ErrRankDeficient {
+			t.Errorf("entry %v: expected ErrRankDeficient, got %v, %v", bad, x, err)
+		}
+	}
+}
+
+func TestLeastSquares_MismatchedDimensions(t *testing.T) {
+	a, _ := matrix.Identity[float64](3)
+	b := &data.Vector[float64]{Element: []float64{1, 2}}
+
+	_, _, err := matrix.LeastSquares(a, b)
+
+	if err != matrix.ErrMismatchedDimensions {
+		t.Errorf("expected ErrMismatchedDimensions, got: %v", err)
+	}
+}
@@ -0,0 +1,234 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// SVD holds the thin singular value decomposition A = U*diag(S)*V^T of an
+// m x n matrix, with k = min(m, n) singular values in descending order,
+// U of size m x k and V of size n x k.
+type SVD struct {
+	u *Matrix[float64]
+	s []float64
+	v *Matrix[float64]
+}
+
+// DecomposeSVD computes the singular value decomposition of m using the
+// one-sided Jacobi method. Returns ErrEmptyMatrix for a matrix without
+// elements and ErrNoConvergence if the column orthogonalization does not
+// settle within DefaultMaxSweeps sweeps.
+//
+// Columns of U that belong to zero singular values are left as zero vectors.
+func DecomposeSVD[T data.Number](m *Matrix[T]) (*SVD, error) {
+	if m.Rows == 0 || m.Cols == 0 {
+		return nil, ErrEmptyMatrix
+	}
+	a := toFloat64(m)
+	// Work on A/max|a_ij| so the column sums of squares cannot overflow
+	scale := normalize(a)
+	transposed := a.Rows < a.Cols
+	if transposed {
+		// Decompose A^T = V*S*U^T and swap the factors
+		a = a.Transpose()
+	}
+	f, err := jacobiSVD(a)
+	if err != nil {
+		return nil, err
+	}
+	if transposed {
+		f.u, f.v = f.v, f.u
+	}
+	for i := range f.s {
+		f.s[i] *= scale
+	}
+	return f, nil
+}
+
+// jacobiSVD decomposes a tall matrix (Rows >= Cols), overwriting it with U*S
+func jacobiSVD(a *Matrix[float64]) (*SVD, error) {
+	rows, cols := a.Rows, a.Cols
+	v, _ := Identity[float64](cols)
+
+	converged := false
+	for sweep := 0; sweep < DefaultMaxSweeps; sweep++ {
+		rotated := false
+		for p := 0; p < cols; p++ {
+			for q := p + 1; q < cols; q++ {
+				var alpha, beta, gamma float64
+				for i := 0; i < rows; i++ {
+					up, uq := a.Element[i*cols+p], a.Element[i*cols+q]
+					alpha += up * up
+					beta += uq * uq
+					gamma += up * uq
+				}
+				if gamma == 0 || math.Abs(gamma) <= epsilon*math.Sqrt(alpha*beta) {
+					continue
+				}
+				rotated = true
+
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (math.Abs(zeta) + math.Hypot(zeta, 1))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / math.Hypot(t, 1)
+				s := t * c
+
+				for i := 0; i < rows; i++ {
+					up, uq := a.Element[i*cols+p], a.Element[i*cols+q]
+					a.Element[i*cols+p] = c*up - s*uq
+					a.Element[i*cols+q] = s*up + c*uq
+				}
+				for i := 0; i < cols; i++ {
+					vp, vq := v.Element[i*cols+p], v.Element[i*cols+q]
+					v.Element[i*cols+p] = c*vp - s*vq
+					v.Element[i*cols+q] = s*vp + c*vq
+				}
+			}
+		}
+		if !rotated {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return nil, ErrNoConvergence
+	}
+
+	// Singular values are the column norms of the rotated matrix
+	sigma := make([]float64, cols)
+	for j := 0; j < cols; j++ {
+		var norm float64
+		for i := 0; i < rows; i++ {
+			norm = math.Hypot(norm, a.Element[i*cols+j])
+		}
+		sigma[j] = norm
+	}
+
+	order := make([]int, cols)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return sigma[order[i]] > sigma[order[j]]
+	})
+
+	s := make([]float64, cols)
+	u := make([]float64, rows*cols)
+	vs := make([]float64, cols*cols)
+	for j, src := range order {
+		s[j] = sigma[src]
+		if s[j] != 0 {
+			for i := 0; i < rows; i++ {
+				u[i*cols+j] = a.Element[i*cols+src] / s[j]
+			}
+		}
+		for i := 0; i < cols; i++ {
+			vs[i*cols+j] = v.Element[i*cols+src]
+		}
+	}
+	return &SVD{
+		u: &Matrix[float64]{Rows: rows, Cols: cols, Element: u},
+		s: s,
+		v: &Matrix[float64]{Rows: cols, Cols: cols, Element: vs},
+	}, nil
+}
+
+// U returns a copy of the left singular vectors as columns
+func (f *SVD) U() *Matrix[float64] {
+	return f.u.Clone()
+}
+
+// S returns the singular values in descending order
+func (f *SVD) S() *data.Vector[float64] {
+	s := make([]float64, len(f.s))
+	copy(s, f.s)
+	return &data.Vector[float64]{Element: s}
+}
+
+// V returns a copy of the right singular vectors as columns
+func (f *SVD) V() *Matrix[float64] {
+	return f.v.Clone()
+}
+
+// defaultTol returns the tolerance below which a singular value counts as zero
+func (f *SVD) defaultTol() float64 {
+	return float64(max(f.u.Rows, f.v.Rows)) * epsilon * f.s[0]
+}
+
+// Rank returns the number of singular values greater than tol.
+// A non-positive tol selects max(m, n) * eps * largest singular value.
+func (f *SVD) Rank(tol float64) int {
+	if tol <= 0 {
+		tol = f.defaultTol()
+	}
+	rank := 0
+	for _, s := range f.s {
+		if s > tol {
+			rank++
+		}
+	}
+	return rank
+}
+
+// Cond returns the 2-norm condition number, the ratio of the largest to the
+// smallest singular value. It is +Inf for rank-deficient matrices.
+func (f *SVD) Cond() float64 {
+	smallest := f.s[len(f.s)-1]
+	if smallest == 0 {
+		return math.Inf(1)
+	}
+	return f.s[0] / smallest
+}
+
+// PseudoInverse returns the Moore-Penrose pseudo-inverse V*diag(1/S)*U^T,
+// treating singular values below the default Rank tolerance as zero.
+func (f *SVD) PseudoInverse() *Matrix[float64] {
+	rows, cols, k := f.v.Rows, f.u.Rows, len(f.s)
+	tol := f.defaultTol()
+	pinv := make([]float64, rows*cols)
+	for l := 0; l < k; l++ {
+		if f.s[l] <= tol {
+			continue
+		}
+		inv := 1 / f.s[l]
+		for i := 0; i < rows; i++ {
+			vil := f.v.Element[i*k+l] * inv
+			for j := 0; j < cols; j++ {
+				pinv[i*cols+j] += vil * f.u.Element[j*k+l]
+			}
+		}
+	}
+	return &Matrix[float64]{Rows: rows, Cols: cols, Element: pinv}
+}
+
+// Rank returns the numerical rank of m using its singular values.
+// A non-positive tol selects max(m, n) * eps * largest singular value.
+func Rank[T data.Number](m *Matrix[T], tol float64) (int, error) {
+	f, err := DecomposeSVD(m)
+	if err != nil {
+		return 0, err
+	}
+	return f.Rank(tol), nil
+}
+
+// Cond returns the 2-norm condition number of m
+func Cond[T data.Number](m *Matrix[T]) (float64, error) {
+	f, err := DecomposeSVD(m)
+	if err != nil {
+		return 0, err
+	}
+	return f.Cond(), nil
+}
+
+// PseudoInverse returns the Moore-Penrose pseudo-inverse of m
+func PseudoInverse[T data.Number](m *Matrix[T]) (*Matrix[float64], error) {
+	f, err := DecomposeSVD(m)
+	if err != nil {
+		return nil, err
+	}
+	return f.PseudoInverse(), nil
+}
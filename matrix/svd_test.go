@@ -0,0 +1,179 @@
+package matrix_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wendersoon/gomathx/matrix"
+)
+
+// TestDecomposeSVD tests the SVD factorization
+func TestDecomposeSVD_Reconstruct(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]float64
+	}{
+		{"Tall", [][]float64{{3, 2}, {2, 3}, {2, -2}}},
+		{"Wide", [][]float64{{3, 2, 2}, {2, 3, -2}}},
+		{"Square", [][]float64{{4, 0, 1}, {-2, 5, 3}, {1, 1, 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := matrix.CreateMatrix(tt.rows)
+			f, err := matrix.DecomposeSVD(a)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+
+			u, s, v := f.U(), f.S(), f.V()
+			for i := 1; i < s.Len(); i++ {
+				if s.Element[i] > s.Element[i-1] {
+					t.Fatalf("singular values not descending: %v", s.Element)
+				}
+			}
+
+			// Scale the columns of U by S and multiply by V^T
+			us := u.Clone()
+			for i := 0; i < us.Rows; i++ {
+				for j := 0; j < us.Cols; j++ {
+					us.Element[i*us.Cols+j] *= s.Element[j]
+				}
+			}
+			usvt, _ := matrix.Mul(us, v.Transpose())
+			for i := range a.Element {
+				if math.Abs(usvt.Element[i]-a.Element[i]) > 1e-12 {
+					t.Fatalf("U*S*V^T = %v, want %v", usvt.Element, a.Element)
+				}
+			}
+		})
+	}
+}
+
+func TestDecomposeSVD_KnownValues(t *testing.T) {
+	// Singular values of [[3, 2, 2], [2, 3, -2]] are 5 and 3
+	a, _ := matrix.CreateMatrix([][]int{{3, 2, 2}, {2, 3, -2}})
+
+	f, err := matrix.DecomposeSVD(a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []float64{5, 3}
+	for i, val := range f.S().Element {
+		if math.Abs(val-expected[i]) > 1e-12 {
+			t.Errorf("singular value %d = %v, want %v", i, val, expected[i])
+		}
+	}
+}
+
+func TestDecomposeSVD_ExtremeMagnitudes(t *testing.T) {
+	for _, scale := range []float64{1e200, 1e-200} {
+		a, _ := matrix.CreateMatrix([][]float64{{scale, scale, scale}, {scale, scale, scale}})
+
+		f, err := matrix.DecomposeSVD(a)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		s := f.S().Element
+		if math.Abs(s[0]-math.Sqrt(6)*scale) > 1e-12*scale || s[1] > 1e-12*scale {
+			t.Errorf("scale %g: S = %v, want [%v 0]", scale, s, math.Sqrt(6)*scale)
+		}
+		if rank := f.Rank(0); rank != 1 {
+			t.Errorf("scale %g: Rank(0) = %d, want 1", scale, rank)
+		}
+	}
+}
+
+// TestRank tests the Rank function
+func TestRank(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     [][]float64
+		expected int
+	}{
+		{"Full rank", [][]float64{{1, 0}, {0, 1}}, 2},
+		{"Rank one", [][]float64{{1, 2, 3}, {2, 4, 6}, {3, 6, 9}}, 1},
+		{"Zero matrix", [][]float64{{0, 0}, {0, 0}}, 0},
+		{"Wide rank two", [][]float64{{1, 2, 3, 4}, {2, 4, 6, 8}, {0, 1, 0, 1}}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := matrix.CreateMatrix(tt.rows)
+			got, err := matrix.Rank(a, 0)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Rank() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCond tests the Cond function
+func TestCond(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{10, 0}, {0, 0.5}})
+	got, err := matrix.Cond(a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if math.Abs(got-20) > 1e-12 {
+		t.Errorf("Cond() = %v, want 20", got)
+	}
+
+	singular, _ := matrix.CreateMatrix([][]float64{{1, 1}, {1, 1}})
+	if got, _ := matrix.Cond(singular); got < 1e15 {
+		t.Errorf("Cond() of singular matrix = %v, want a huge or infinite value", got)
+	}
+}
+
+// TestPseudoInverse tests the PseudoInverse function
+func TestPseudoInverse_MatchesInverse(t *testing.T) {
+	a, _ := matrix.CreateMatrix([][]float64{{4, 7}, {2, 6}})
+
+	pinv, err := matrix.PseudoInverse(a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	inv, _ := matrix.Inverse(a)
+
+	for i := range inv.Element {
+		if math.Abs(pinv.Element[i]-inv.Element[i]) > 1e-12 {
+			t.Fatalf("PseudoInverse() = %v, want %v", pinv.Element, inv.Element)
+		}
+	}
+}
+
+func TestPseudoInverse_RankDeficient(t *testing.T) {
+	// The pseudo-inverse of the rank-one matrix x*y^T is y*x^T / (|x|^2 |y|^2)
+	a, _ := matrix.CreateMatrix([][]float64{{1, 2}, {2, 4}, {3, 6}})
+
+	pinv, err := matrix.PseudoInverse(a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if pinv.Rows != 2 || pinv.Cols != 3 {
+		t.Fatalf("expected 2x3 pseudo-inverse, got %dx%d", pinv.Rows, pinv.Cols)
+	}
+
+	expected := a.Transpose()
+	for i := range expected.Element {
+		expected.Element[i] /= 70
+	}
+	for i := range expected.Element {
+		if math.Abs(pinv.Element[i]-expected.Element[i]) > 1e-12 {
+			t.Fatalf("PseudoInverse() = %v, want %v", pinv.Element, expected.Element)
+		}
+	}
+}
+
+func BenchmarkDecomposeSVD50(b *testing.B) {
+	a := spdMatrix(50)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		matrix.DecomposeSVD(a)
+	}
+}
@@ -0,0 +1,18 @@
+// Package parallel provides the opt-in worker pool used by the data and
+// vector packages for operations on very large vectors.
+//
+// Parallel execution is disabled by default. Enable it by setting more than
+// one worker:
+//
+//	parallel.SetConfig(parallel.Config{Workers: runtime.GOMAXPROCS(0)})
+//
+// Once enabled, Sum, Apply, DotProduct, EuclideanDistance and the element-wise
+// arithmetic functions split vectors of at least Threshold elements into
+// chunks of ChunkSize elements and process the chunks concurrently. Vectors
+// below the threshold keep using the serial code path.
+//
+// Reductions combine the per-chunk results in chunk order. Because chunk
+// boundaries depend only on ChunkSize, floating-point sums are reproducible
+// between runs and independent of the number of workers. They may differ in
+// the last bits from the serial result, which adds the elements in one pass.
+package parallel
@@ -0,0 +1,125 @@
+package parallel
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// DefaultThreshold is the minimum vector length processed in parallel
+	DefaultThreshold = 1 << 16
+	// DefaultChunkSize is the number of elements in each unit of work
+	DefaultChunkSize = 1 << 14
+)
+
+// Config controls when and how operations run in parallel
+type Config struct {
+	// Workers is the number of goroutines used. Zero or one disables
+	// parallel execution.
+	Workers int
+	// Threshold is the minimum number of elements for an operation to run in
+	// parallel. Zero means DefaultThreshold.
+	Threshold int
+	// ChunkSize is the number of elements handed to a worker at a time and
+	// fixes the order of floating-point reductions. Zero means
+	// DefaultChunkSize.
+	ChunkSize int
+}
+
+var current atomic.Pointer[Config]
+
+// SetConfig replaces the package configuration and returns the previous one,
+// so a caller can restore it with defer parallel.SetConfig(parallel.SetConfig(c)).
+// It is safe to call concurrently with running operations, which keep the
+// configuration they started with.
+func SetConfig(c Config) Config {
+	if c.Workers < 1 {
+		c.Workers = 1
+	}
+	if c.Threshold <= 0 {
+		c.Threshold = DefaultThreshold
+	}
+	if c.ChunkSize <= 0 {
+		c.ChunkSize = DefaultChunkSize
+	}
+	if old := current.Swap(&c); old != nil {
+		return *old
+	}
+	return Config{Workers: 1, Threshold: DefaultThreshold, ChunkSize: DefaultChunkSize}
+}
+
+// CurrentConfig returns the configuration in effect
+func CurrentConfig() Config {
+	if c := current.Load(); c != nil {
+		return *c
+	}
+	return Config{Workers: 1, Threshold: DefaultThreshold, ChunkSize: DefaultChunkSize}
+}
+
+// Active reports whether an operation over n elements runs in parallel
+func Active(n int) bool {
+	c := current.Load()
+	return c != nil && c.Workers > 1 && n >= c.Threshold
+}
+
+// For calls body for consecutive ranges [lo, hi) covering [0, n). When Active(n)
+// is true the ranges are chunks processed concurrently, otherwise body is
+// called once with the whole range. Calls for different chunks must not write
+// to the same memory.
+func For(n int, body func(lo, hi int)) {
+	c := CurrentConfig()
+	if c.Workers <= 1 || n < c.Threshold {
+		body(0, n)
+		return
+	}
+	run(n, c, body)
+}
+
+// Reduce computes partial results for consecutive ranges covering [0, n) and
+// folds them with combine in range order. The result only depends on n and
+// the configured ChunkSize, never on the number of workers or on scheduling.
+// When Active(n) is false it returns partial(0, n).
+func Reduce[R any](n int, partial func(lo, hi int) R, combine func(R, R) R) R {
+	c := CurrentConfig()
+	if c.Workers <= 1 || n < c.Threshold {
+		return partial(0, n)
+	}
+	results := make([]R, chunks(n, c.ChunkSize))
+	run(n, c, func(lo, hi int) {
+		results[lo/c.ChunkSize] = partial(lo, hi)
+	})
+	acc := results[0]
+	for _, r := range results[1:] {
+		acc = combine(acc, r)
+	}
+	return acc
+}
+
+// run distributes the chunks of [0, n) over c.Workers goroutines
+func run(n int, c Config, body func(lo, hi int)) {
+	total := chunks(n, c.ChunkSize)
+	workers := min(c.Workers, total)
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				k := int(next.Add(1) - 1)
+				if k >= total {
+					return
+				}
+				lo := k * c.ChunkSize
+				body(lo, min(lo+c.ChunkSize, n))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// chunks returns the number of chunks of size chunk needed to cover n elements
+func chunks(n, chunk int) int {
+	return (n + chunk - 1) / chunk
+}
@@ -0,0 +1,93 @@
+package parallel
+
+import (
+	"math"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSetConfigDefaults(t *testing.T) {
+	defer SetConfig(SetConfig(Config{Workers: -3}))
+
+	c := CurrentConfig()
+	if c.Workers != 1 || c.Threshold != DefaultThreshold || c.ChunkSize != DefaultChunkSize {
+		t.Errorf("CurrentConfig() = %+v, want defaults with one worker", c)
+	}
+	if Active(1 << 30) {
+		t.Error("Active() = true with a single worker")
+	}
+}
+
+func TestActive(t *testing.T) {
+	defer SetConfig(SetConfig(Config{Workers: 4, Threshold: 100}))
+
+	if Active(99) {
+		t.Error("Active(99) = true below the threshold")
+	}
+	if !Active(100) {
+		t.Error("Active(100) = false at the threshold")
+	}
+}
+
+func TestForCoversRange(t *testing.T) {
+	defer SetConfig(SetConfig(Config{Workers: 4, Threshold: 1, ChunkSize: 7}))
+
+	const n = 1000
+	var seen [n]atomic.Int32
+	For(n, func(lo, hi int) {
+		if hi-lo > 7 {
+			t.Errorf("chunk [%d, %d) larger than ChunkSize", lo, hi)
+		}
+		for i := lo; i < hi; i++ {
+			seen[i].Add(1)
+		}
+	})
+
+	for i := range seen {
+		if got := seen[i].Load(); got != 1 {
+			t.Fatalf("index %d visited %d times, want 1", i, got)
+		}
+	}
+}
+
+func TestForSerial(t *testing.T) {
+	calls := 0
+	For(10, func(lo, hi int) {
+		calls++
+		if lo != 0 || hi != 10 {
+			t.Errorf("For() called body(%d, %d), want body(0, 10)", lo, hi)
+		}
+	})
+	if calls != 1 {
+		t.Errorf("body called %d times, want 1", calls)
+	}
+}
+
+func TestReduceDeterministic(t *testing.T) {
+	const n = 100000
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = math.Sin(float64(i)) * math.Pow(10, float64(i%7))
+	}
+	partial := func(lo, hi int) float64 {
+		var s float64
+		for _, v := range x[lo:hi] {
+			s += v
+		}
+		return s
+	}
+	combine := func(a, b float64) float64 { return a + b }
+
+	prev := SetConfig(Config{Workers: 2, Threshold: 1, ChunkSize: 1000})
+	defer SetConfig(prev)
+	want := Reduce(n, partial, combine)
+
+	for _, workers := range []int{3, 8, 16} {
+		SetConfig(Config{Workers: workers, Threshold: 1, ChunkSize: 1000})
+		for run := 0; run < 5; run++ {
+			if got := Reduce(n, partial, combine); got != want {
+				t.Fatalf("Reduce() with %d workers = %v, want %v", workers, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package vector
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/matrix"
+)
+
+// Correlation selects the correlation coefficient computed by CorrelationMatrix
+type Correlation int
+
+const (
+	// CorrPearson is the Pearson product-moment correlation
+	CorrPearson Correlation = iota
+	// CorrSpearman is the Spearman rank correlation
+	CorrSpearman
+	// CorrKendall is the Kendall tau-b rank correlation
+	CorrKendall
+)
+
+// Covariance returns the sample covariance of a and b, dividing by n - 1 as
+// NumPy's cov and pandas do. Returns ErrMismatchedLengths if the lengths
+// differ and ErrTooFewElements for fewer than two elements.
+func Covariance[T data.Number](a, b *data.Vector[T]) (float64, error) {
+	if err := checkPair(a, b); err != nil {
+		return 0, err
+	}
+	meanA, _ := a.Mean()
+	meanB, _ := b.Mean()
+	var sum float64
+	for i := range a.Len() {
+		sum += (float64(a.At(i)) - meanA) * (float64(b.At(i)) - meanB)
+	}
+	return sum / float64(a.Len()-1), nil
+}
+
+// Pearson returns the Pearson correlation coefficient of a and b.
+// Returns ErrZeroVariance if either vector is constant.
+func Pearson[T data.Number](a, b *data.Vector[T]) (float64, error) {
+	if err := checkPair(a, b); err != nil {
+		return 0, err
+	}
+	meanA, _ := a.Mean()
+	meanB, _ := b.Mean()
+	var sab, saa, sbb float64
+	for i := range a.Len() {
+		da, db := float64(a.At(i))-meanA, float64(b.At(i))-meanB
+		sab += da * db
+		saa += da * da
+		sbb += db * db
+	}
+	if saa == 0 || sbb == 0 {
+		return 0, ErrZeroVariance
+	}
+	// Rounding can push perfectly correlated data just outside [-1, 1]
+	return max(-1, min(1, sab/math.Sqrt(saa*sbb))), nil
+}
+
+// Spearman returns the Spearman rank correlation of a and b: the Pearson
+// correlation of their ranks, where tied elements share the average of the
+// ranks they span. Returns ErrZeroVariance if either vector is constant.
+func Spearman[T data.Number](a, b *data.Vector[T]) (float64, error) {
+	if err := checkPair(a, b); err != nil {
+		return 0, err
+	}
+	return Pearson(a.Rank(data.RankAverage), b.Rank(data.RankAverage))
+}
+
+// KendallTau returns the Kendall tau-b rank correlation of a and b, which
+// corrects for ties in either vector. It uses Knight's O(n log n) algorithm.
+// Returns ErrZeroVariance if either vector is constant.
+func KendallTau[T data.Number](a, b *data.Vector[T]) (float64, error) {
+	if err := checkPair(a, b); err != nil {
+		return 0, err
+	}
+	n := a.Len()
+
+	// Sort by a, breaking ties by b
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	slices.SortFunc(perm, func(i, j int) int {
+		if c := cmp.Compare(a.At(i), a.At(j)); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.At(i), b.At(j))
+	})
+
+	// Pairs tied in a, and tied in both a and b
+	var tiedA, tiedBoth int64
+	for i, runA, runAB := 1, int64(1), int64(1); i <= n; i++ {
+		if i < n && a.At(perm[i]) == a.At(perm[i-1]) {
+			runA++
+			if b.At(perm[i]) == b.At(perm[i-1]) {
+				runAB++
+			} else {
+				tiedBoth += runAB * (runAB - 1) / 2
+				runAB = 1
+			}
+			continue
+		}
+		tiedA += runA * (runA - 1) / 2
+		tiedBoth += runAB * (runAB - 1) / 2
+		runA, runAB = 1, 1
+	}
+
+	// Sorting the b values in this order by merge sort counts the discordant
+	// pairs as the number of swaps
+	ys := make([]T, n)
+	for k, i := range perm {
+		ys[k] = b.At(i)
+	}
+	swaps := mergeCount(ys, make([]T, n))
+
+	// Pairs tied in b, now that ys is sorted
+	var tiedB int64
+	for i, run := 1, int64(1); i <= n; i++ {
+		if i < n && ys[i] == ys[i-1] {
+			run++
+			continue
+		}
+		tiedB += run * (run - 1) / 2
+		run = 1
+	}
+
+	pairs := int64(n) * int64(n-1) / 2
+	if tiedA == pairs || tiedB == pairs {
+		return 0, ErrZeroVariance
+	}
+	concordantMinusDiscordant := pairs - tiedA - tiedB + tiedBoth - 2*swaps
+	return float64(concordantMinusDiscordant) / math.Sqrt(float64(pairs-tiedA)*float64(pairs-tiedB)), nil
+}
+
+// CovarianceMatrix returns the sample covariance matrix of the vectors, whose
+// element (i, j) is Covariance(vectors[i], vectors[j]). Every vector is one
+// variable and must have the same length.
+func CovarianceMatrix[T data.Number](vectors ...*data.Vector[T]) (*matrix.Matrix[float64], error) {
+	return pairwise(vectors, true, Covariance[T])
+}
+
+// CorrelationMatrix returns the matrix whose element (i, j) is the method
+// correlation of vectors[i] and vectors[j]. The diagonal is exactly one.
+// Returns ErrTooFewElements or ErrZeroVariance if any vector has fewer than
+// two elements or is constant.
+func CorrelationMatrix[T data.Number](method Correlation, vectors ...*data.Vector[T]) (*matrix.Matrix[float64], error) {
+	switch method {
+	case CorrSpearman:
+		// Rank every vector once instead of once per pair
+		ranks := make([]*data.Vector[float64], len(vectors))
+		for i, v := range vectors {
+			if v.Len() != vectors[0].Len() {
+				return nil, ErrMismatchedLengths
+			}
+			ranks[i] = v.Rank(data.RankAverage)
+		}
+		return pairwise(ranks, false, Pearson[float64])
+	case CorrKendall:
+		return pairwise(vectors, false, KendallTau[T])
+	default:
+		return pairwise(vectors, false, Pearson[T])
+	}
+}
+
+// pairwise builds the symmetric matrix of f over all pairs of vectors. The
+// diagonal is computed with f when withDiagonal is set and is one otherwise,
+// but f is still evaluated on it so that short or constant vectors are
+// reported with the same errors as f(v, v).
+func pairwise[T data.Number](vectors []*data.Vector[T], withDiagonal bool, f func(a, b *data.Vector[T]) (float64, error)) (*matrix.Matrix[float64], error) {
+	k := len(vectors)
+	m, err := matrix.Zeros[float64](k, k)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < k; i++ {
+		for j := i; j < k; j++ {
+			val, err := f(vectors[i], vectors[j])
+			if err != nil {
+				return nil, err
+			}
+			if i == j && !withDiagonal {
+				val = 1
+			}
+			m.Element[i*k+j] = val
+			m.Element[j*k+i] = val
+		}
+	}
+	return m, nil
+}
+
+// checkPair validates the arguments of a two-vector statistic
+func checkPair[T data.Number](a, b *data.Vector[T]) error {
+	if a.Len() != b.Len() {
+		return ErrMismatchedLengths
+	}
+	if a.Len() < 2 {
+		return ErrTooFewElements
+	}
+	return nil
+}
+
+// mergeCount sorts xs with a merge sort using buf as scratch space and
+// returns the number of pairs i < j with xs[i] > xs[j] in the original order
+func mergeCount[T data.Number](xs, buf []T) int64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	mid := len(xs) / 2
+	swaps := mergeCount(xs[:mid], buf[:mid]) + mergeCount(xs[mid:], buf[mid:])
+
+	i, j, k := 0, mid, 0
+	for i < mid && j < len(xs) {
+		if xs[j] < xs[i] {
+			// xs[j] is smaller than every remaining element of the left half
+			swaps += int64(mid - i)
+			buf[k] = xs[j]
+			j++
+		} else {
+			buf[k] = xs[i]
+			i++
+		}
+		k++
+	}
+	k += copy(buf[k:], xs[i:mid])
+	copy(buf[k:], xs[j:])
+	copy(xs, buf[:len(xs)])
+	return swaps
+}
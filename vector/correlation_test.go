@@ -0,0 +1,185 @@
+package vector_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/vector"
+)
+
+func TestCorrelations(t *testing.T) {
+	// Expected values from numpy.cov and scipy.stats
+	tests := []struct {
+		name     string
+		f        func(a, b *data.Vector[float64]) (float64, error)
+		a, b     []float64
+		expected float64
+	}{
+		{"Covariance", vector.Covariance[float64], []float64{1, 2, 3, 4}, []float64{2, 4, 6, 9}, 11.5 / 3},
+		{"Pearson", vector.Pearson[float64], []float64{1, 2, 3, 4, 5}, []float64{2, 4, 5, 4, 5}, 0.7745966692414834},
+		{"Pearson perfect", vector.Pearson[float64], []float64{1, 2, 3}, []float64{-2, -4, -6}, -1},
+		{"Spearman with ties", vector.Spearman[float64], []float64{1, 2, 3, 4, 5}, []float64{5, 6, 7, 8, 7}, 0.8207826816681233},
+		{"Spearman monotonic", vector.Spearman[float64], []float64{1, 2, 3, 4}, []float64{1, 10, 100, 1000}, 1},
+		{"KendallTau with ties", vector.KendallTau[float64], []float64{12, 2, 1, 12, 2}, []float64{1, 4, 7, 1, 0}, -0.47140452079103173},
+		{"KendallTau reversed", vector.KendallTau[float64], []float64{1, 2, 3}, []float64{3, 2, 1}, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := vector.CreateVector(tt.a)
+			b, _ := vector.CreateVector(tt.b)
+			got, err := tt.f(a, b)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if math.Abs(got-tt.expected) > 1e-12 {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// kendallBruteForce computes tau-b by comparing every pair
+func kendallBruteForce(a, b []int) float64 {
+	var concordant, discordant, tiedA, tiedB float64
+	for i := range a {
+		for j := i + 1; j < len(a); j++ {
+			da, db := a[i]-a[j], b[i]-b[j]
+			switch {
+			case da == 0 && db == 0:
+			case da == 0:
+				tiedA++
+			case db == 0:
+				tiedB++
+			case (da > 0) == (db > 0):
+				concordant++
+			default:
+				discordant++
+			}
+		}
+	}
+	return (concordant - discordant) / math.Sqrt((concordant+discordant+tiedA)*(concordant+discordant+tiedB))
+}
+
+func TestKendallTau_MatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	for trial := 0; trial < 100; trial++ {
+		n := 2 + rng.Intn(60)
+		a, b := make([]int, n), make([]int, n)
+		for i := range a {
+			a[i], b[i] = rng.Intn(8), rng.Intn(8)
+		}
+		va, _ := vector.CreateVector(a)
+		vb, _ := vector.CreateVector(b)
+
+		got, err := vector.KendallTau(va, vb)
+		want := kendallBruteForce(a, b)
+		if math.IsNaN(want) {
+			if err != vector.ErrZeroVariance {
+				t.Fatalf("a=%v b=%v: expected ErrZeroVariance, got %v, %v", a, b, got, err)
+			}
+			continue
+		}
+		if err != nil || math.Abs(got-want) > 1e-12 {
+			t.Fatalf("a=%v b=%v: expected %v, got %v, %v", a, b, want, got, err)
+		}
+	}
+}
+
+func TestCorrelations_Errors(t *testing.T) {
+	a, _ := vector.CreateVector([]int{1, 2, 3})
+	constant, _ := vector.CreateVector([]int{4, 4, 4})
+	short, _ := vector.CreateVector([]int{1, 2})
+	single, _ := vector.CreateVector([]int{1})
+
+	if _, err := vector.Pearson(a, short); err != vector.ErrMismatchedLengths {
+		t.Errorf("expected ErrMismatchedLengths, got: %v", err)
+	}
+	if _, err := vector.Covariance(single, single); err != vector.ErrTooFewElements {
+		t.Errorf("expected ErrTooFewElements, got: %v", err)
+	}
+	for name, f := range map[string]func(a, b *data.Vector[int]) (float64, error){
+		"Pearson":    vector.Pearson[int],
+		"Spearman":   vector.Spearman[int],
+		"KendallTau": vector.KendallTau[int],
+	} {
+		if _, err := f(a, constant); err != vector.ErrZeroVariance {
+			t.Errorf("%s: expected ErrZeroVariance, got: %v", name, err)
+		}
+	}
+}
+
+func TestCovarianceAndCorrelationMatrix(t *testing.T) {
+	x, _ := vector.CreateVector([]float64{1, 2, 3, 4})
+	y, _ := vector.CreateVector([]float64{2, 4, 6, 9})
+	z, _ := vector.CreateVector([]float64{4, 3, 2, 1})
+
+	cov, err := vector.CovarianceMatrix(x, y, z)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if cov.Rows != 3 || cov.Cols != 3 {
+		t.Fatalf("expected 3x3 matrix, got %dx%d", cov.Rows, cov.Cols)
+	}
+	varX, _ := x.Variance(1)
+	if got, _ := cov.At(0, 0); math.Abs(got-varX) > 1e-12 {
+		t.Errorf("expected variance %v on the diagonal, got %v", varX, got)
+	}
+	if got, _ := cov.At(1, 0); math.Abs(got-11.5/3) > 1e-12 {
+		t.Errorf("expected covariance %v, got %v", 11.5/3, got)
+	}
+
+	for _, method := range []vector.Correlation{vector.CorrPearson, vector.CorrSpearman, vector.CorrKendall} {
+		corr, err := vector.CorrelationMatrix(method, x, y, z)
+		if err != nil {
+			t.Fatalf("method %d: expected no error, got: %v", method, err)
+		}
+		for i := 0; i < 3; i++ {
+			if d, _ := corr.At(i, i); d != 1 {
+				t.Errorf("method %d: expected 1 on the diagonal, got %v", method, d)
+			}
+			for j := 0; j < 3; j++ {
+				if aij, _ := corr.At(i, j); aij < -1 || aij > 1 {
+					t.Errorf("method %d: element (%d, %d) = %v outside [-1, 1]", method, i, j, aij)
+				}
+			}
+		}
+		if got, _ := corr.At(0, 2); math.Abs(got+1) > 1e-12 {
+			t.Errorf("method %d: expected -1 for reversed vectors, got %v", method, got)
+		}
+	}
+
+	short, _ := vector.CreateVector([]float64{1, 2})
+	if _, err := vector.CorrelationMatrix(vector.CorrSpearman, x, short); err != vector.ErrMismatchedLengths {
+		t.Errorf("expected ErrMismatchedLengths, got: %v", err)
+	}
+
+	// The diagonal reports the same errors as correlating a vector with itself
+	single, _ := vector.CreateVector([]float64{1})
+	constant, _ := vector.CreateVector([]float64{3, 3, 3, 3})
+	for _, method := range []vector.Correlation{vector.CorrPearson, vector.CorrSpearman, vector.CorrKendall} {
+		if _, err := vector.CorrelationMatrix(method, single); err != vector.ErrTooFewElements {
+			t.Errorf("method %d: single element error = %v, want ErrTooFewElements", method, err)
+		}
+		if _, err := vector.CorrelationMatrix(method, constant); err != vector.ErrZeroVariance {
+			t.Errorf("method %d: constant vector error = %v, want ErrZeroVariance", method, err)
+		}
+	}
+}
+
+func BenchmarkKendallTau(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x, y := make([]float64, 10000), make([]float64, 10000)
+	for i := range x {
+		x[i], y[i] = rng.Float64(), rng.Float64()
+	}
+	vx, _ := vector.CreateVector(x)
+	vy, _ := vector.CreateVector(y)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		vector.KendallTau(vx, vy)
+	}
+}
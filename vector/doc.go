@@ -11,9 +11,25 @@
 //   - SubVectors: Element-wise subtraction
 //   - MulVectors: Element-wise multiplication
 //   - DivVectors: Element-wise division with zero-check
+//   - AddScalar, SubScalar, DivScalar, Pow, Clip: Arithmetic with a scalar
+//   - AddInto, SubInto, MulInto, DivInto: Allocation-free variants that
+//     write into a caller-provided destination
+//   - DotProductWith: Dot product with a selectable summation method
+//   - CheckedDotProduct: Dot product that reports integer overflow
+//   - WeightedMean, WeightedStdDev, WeightedQuantiles, WeightedHistogram:
+//     Statistics with one non-negative weight per element
+//   - Covariance, Pearson, Spearman, KendallTau: Pairwise statistics, with
+//     CovarianceMatrix and CorrelationMatrix for sets of vectors
+//   - Greater, Less, Equal, Where: Element-wise comparisons producing a
+//     data.Mask, and selection between two vectors by mask
+//   - CreateSparseVector: Safe sparse vector creation with index validation
+//   - SparseDotProduct, SparseCosineSimilarity, AddSparseVectors: Sparse
+//     counterparts that only visit stored elements
 //
 // All arithmetic operations require vectors of equal length and will
-// return ErrMismatchedLengths if dimensions don't match.
+// return ErrMismatchedLengths if dimensions don't match. AddVectors,
+// SubVectors, MulVectors and DivVectors also accept a vector with a single
+// element, which is broadcast to the length of the other operands.
 //
 // Example:
 //
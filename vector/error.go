@@ -1,8 +1,37 @@
 package vector
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/wendersoon/gomathx/data"
+)
 
 // ErrEmptyVector is returned when trying to create an empty vector
 var ErrEmptyVector = errors.New("empty vector is not allowed")
 
-var ErrMismatchedLengths = errors.New("vectors must have the same length")
+// ErrMismatchedLengths is returned when vectors must have the same length.
+// It is shared with the data package so in-place methods report the same value.
+var ErrMismatchedLengths = data.ErrMismatchedLengths
+
+// ErrDivisionByZero is returned when dividing by a zero element
+var ErrDivisionByZero = errors.New("division by zero")
+
+// ErrInvalidIndices is returned when sparse indices are unsorted, duplicated or out of range
+var ErrInvalidIndices = errors.New("sparse indices must be strictly increasing and within the vector dimension")
+
+// ErrNegativeWeight is returned when a weight is negative or NaN
+var ErrNegativeWeight = errors.New("weights must be non-negative")
+
+// ErrZeroWeights is returned when all weights are zero
+var ErrZeroWeights = errors.New("weights must not all be zero")
+
+// ErrInvalidEdges is returned when histogram bin edges are not strictly
+// increasing or fewer than two. It is shared with the data package.
+var ErrInvalidEdges = data.ErrInvalidEdges
+
+// ErrZeroVariance is returned when a correlation is undefined because a
+// vector is constant
+var ErrZeroVariance = errors.New("correlation undefined for constant vector")
+
+// ErrTooFewElements is returned when a statistic needs at least two elements
+var ErrTooFewElements = errors.New("at least two elements are required")
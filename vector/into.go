@@ -0,0 +1,207 @@
+package vector
+
+import (
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/parallel"
+)
+
+// The Into functions write their result into a caller-provided dst vector
+// instead of allocating one, so they perform no allocations.
+//
+// Aliasing: dst may be the same vector as a or b (or a view covering exactly
+// the same elements), since each output element only depends on the input
+// elements at the same index. Any other overlap between dst and the inputs,
+// such as a view shifted by one element, gives unspecified results.
+//
+// Vectors of at least the parallel threshold are processed concurrently when
+// parallel execution is enabled in the parallel package.
+
+// AddInto stores a + b element-wise in dst.
+// Returns ErrMismatchedLengths unless dst, a and b have the same length.
+func AddInto[T data.Number](dst, a, b *data.Vector[T]) error {
+	n, err := intoLen(dst, a, b)
+	if err != nil {
+		return err
+	}
+	if parallel.Active(n) {
+		parallel.For(n, func(lo, hi int) { addRange(dst, a, b, lo, hi) })
+		return nil
+	}
+	addRange(dst, a, b, 0, n)
+	return nil
+}
+
+// addRange stores a + b in dst for the elements with index in [lo, hi)
+func addRange[T data.Number](dst, a, b *data.Vector[T], lo, hi int) {
+	if d, x, y, ok := contiguous3(dst, a, b, lo, hi); ok {
+		for i := range d {
+			d[i] = x[i] + y[i]
+		}
+		return
+	}
+	sd, sa, sb := stride(dst), stride(a), stride(b)
+	for i := lo; i < hi; i++ {
+		dst.Element[i*sd] = a.Element[i*sa] + b.Element[i*sb]
+	}
+}
+
+// SubInto stores a - b element-wise in dst.
+// Returns ErrMismatchedLengths unless dst, a and b have the same length.
+func SubInto[T data.Number](dst, a, b *data.Vector[T]) error {
+	n, err := intoLen(dst, a, b)
+	if err != nil {
+		return err
+	}
+	if parallel.Active(n) {
+		parallel.For(n, func(lo, hi int) { subRange(dst, a, b, lo, hi) })
+		return nil
+	}
+	subRange(dst, a, b, 0, n)
+	return nil
+}
+
+// subRange stores a - b in dst for the elements with index in [lo, hi)
+func subRange[T data.Number](dst, a, b *data.Vector[T], lo, hi int) {
+	if d, x, y, ok := contiguous3(dst, a, b, lo, hi); ok {
+		for i := range d {
+			d[i] = x[i] - y[i]
+		}
+		return
+	}
+	sd, sa, sb := stride(dst), stride(a), stride(b)
+	for i := lo; i < hi; i++ {
+		dst.Element[i*sd] = a.Element[i*sa] - b.Element[i*sb]
+	}
+}
+
+// MulInto stores a * b element-wise in dst.
+// Returns ErrMismatchedLengths unless dst, a and b have the same length.
+func MulInto[T data.Number](dst, a, b *data.Vector[T]) error {
+	n, err := intoLen(dst, a, b)
+	if err != nil {
+		return err
+	}
+	if parallel.Active(n) {
+		parallel.For(n, func(lo, hi int) { mulRange(dst, a, b, lo, hi) })
+		return nil
+	}
+	mulRange(dst, a, b, 0, n)
+	return nil
+}
+
+// mulRange stores a * b in dst for the elements with index in [lo, hi)
+func mulRange[T data.Number](dst, a, b *data.Vector[T], lo, hi int) {
+	if d, x, y, ok := contiguous3(dst, a, b, lo, hi); ok {
+		for i := range d {
+			d[i] = x[i] * y[i]
+		}
+		return
+	}
+	sd, sa, sb := stride(dst), stride(a), stride(b)
+	for i := lo; i < hi; i++ {
+		dst.Element[i*sd] = a.Element[i*sa] * b.Element[i*sb]
+	}
+}
+
+// DivInto stores a / b element-wise in dst.
+// Returns ErrMismatchedLengths unless dst, a and b have the same length, and
+// ErrDivisionByZero if any element of b is zero, in which case dst is unchanged.
+func DivInto[T data.Number](dst, a, b *data.Vector[T]) error {
+	n, err := intoLen(dst, a, b)
+	if err != nil {
+		return err
+	}
+	sb := stride(b)
+	for i := 0; i < n; i++ {
+		if b.Element[i*sb] == 0 {
+			return ErrDivisionByZero
+		}
+	}
+	if parallel.Active(n) {
+		parallel.For(n, func(lo, hi int) { divRange(dst, a, b, lo, hi) })
+		return nil
+	}
+	divRange(dst, a, b, 0, n)
+	return nil
+}
+
+// divRange stores a / b in dst for the elements with index in [lo, hi)
+func divRange[T data.Number](dst, a, b *data.Vector[T], lo, hi int) {
+	sd, sa, sb := stride(dst), stride(a), stride(b)
+	for i := lo; i < hi; i++ {
+		dst.Element[i*sd] = a.Element[i*sa] / b.Element[i*sb]
+	}
+}
+
+// ElementWiseMaxInto stores the element-wise maximum of a and b in dst.
+// Returns ErrMismatchedLengths unless dst, a and b have the same length.
+func ElementWiseMaxInto[T data.Number](dst, a, b *data.Vector[T]) error {
+	n, err := intoLen(dst, a, b)
+	if err != nil {
+		return err
+	}
+	if parallel.Active(n) {
+		parallel.For(n, func(lo, hi int) { maxRange(dst, a, b, lo, hi) })
+		return nil
+	}
+	maxRange(dst, a, b, 0, n)
+	return nil
+}
+
+// maxRange stores the maximum of a and b in dst for the elements with index in [lo, hi)
+func maxRange[T data.Number](dst, a, b *data.Vector[T], lo, hi int) {
+	sd, sa, sb := stride(dst), stride(a), stride(b)
+	for i := lo; i < hi; i++ {
+		if ai, bi := a.Element[i*sa], b.Element[i*sb]; ai > bi {
+			dst.Element[i*sd] = ai
+		} else {
+			dst.Element[i*sd] = bi
+		}
+	}
+}
+
+// ElementWiseMinInto stores the element-wise minimum of a and b in dst.
+// Returns ErrMismatchedLengths unless dst, a and b have the same length.
+func ElementWiseMinInto[T data.Number](dst, a, b *data.Vector[T]) error {
+	n, err := intoLen(dst, a, b)
+	if err != nil {
+		return err
+	}
+	if parallel.Active(n) {
+		parallel.For(n, func(lo, hi int) { minRange(dst, a, b, lo, hi) })
+		return nil
+	}
+	minRange(dst, a, b, 0, n)
+	return nil
+}
+
+// minRange stores the minimum of a and b in dst for the elements with index in [lo, hi)
+func minRange[T data.Number](dst, a, b *data.Vector[T], lo, hi int) {
+	sd, sa, sb := stride(dst), stride(a), stride(b)
+	for i := lo; i < hi; i++ {
+		if ai, bi := a.Element[i*sa], b.Element[i*sb]; ai < bi {
+			dst.Element[i*sd] = ai
+		} else {
+			dst.Element[i*sd] = bi
+		}
+	}
+}
+
+// intoLen returns the common length of dst, a and b, or ErrMismatchedLengths
+func intoLen[T data.Number](dst, a, b *data.Vector[T]) (int, error) {
+	n := a.Len()
+	if b.Len() != n || dst.Len() != n {
+		return 0, ErrMismatchedLengths
+	}
+	return n, nil
+}
+
+// contiguous3 returns the elements with index in [lo, hi) of dst, a and b as
+// plain slices when all three are contiguous, which lets the compiler drop
+// most bounds checks.
+func contiguous3[T data.Number](dst, a, b *data.Vector[T], lo, hi int) ([]T, []T, []T, bool) {
+	if !dst.IsContiguous() || !a.IsContiguous() || !b.IsContiguous() {
+		return nil, nil, nil, false
+	}
+	return dst.Element[lo:hi], a.Element[lo:hi], b.Element[lo:hi], true
+}
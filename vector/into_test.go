@@ -0,0 +1,143 @@
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/vector"
+)
+
+// TestInto_Results tests the destination-based arithmetic functions
+func TestInto_Results(t *testing.T) {
+	tests := []struct {
+		name     string
+		into     func(dst, a, b *data.Vector[int]) error
+		expected []int
+	}{
+		{"AddInto", vector.AddInto[int], []int{7, 7, 7}},
+		{"SubInto", vector.SubInto[int], []int{-5, -1, 3}},
+		{"MulInto", vector.MulInto[int], []int{6, 12, 10}},
+		{"DivInto", vector.DivInto[int], []int{0, 0, 2}},
+		{"ElementWiseMaxInto", vector.ElementWiseMaxInto[int], []int{6, 4, 5}},
+		{"ElementWiseMinInto", vector.ElementWiseMinInto[int], []int{1, 3, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &data.Vector[int]{Element: []int{1, 3, 5}}
+			b := &data.Vector[int]{Element: []int{6, 4, 2}}
+			dst := &data.Vector[int]{Element: make([]int, 3)}
+
+			if err := tt.into(dst, a, b); err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			for i, val := range dst.Element {
+				if val != tt.expected[i] {
+					t.Errorf("expected %d at index %d, got %d", tt.expected[i], i, val)
+				}
+			}
+		})
+	}
+}
+
+func TestInto_Aliasing(t *testing.T) {
+	a := &data.Vector[int]{Element: []int{1, 2, 3}}
+	b := &data.Vector[int]{Element: []int{10, 20, 30}}
+
+	// dst == a
+	if err := vector.AddInto(a, a, b); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected := []int{11, 22, 33}
+	for i, val := range a.Element {
+		if val != expected[i] {
+			t.Errorf("AddInto(a, a, b): expected %d at index %d, got %d", expected[i], i, val)
+		}
+	}
+
+	// dst == a == b
+	if err := vector.MulInto(b, b, b); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected = []int{100, 400, 900}
+	for i, val := range b.Element {
+		if val != expected[i] {
+			t.Errorf("MulInto(b, b, b): expected %d at index %d, got %d", expected[i], i, val)
+		}
+	}
+}
+
+func TestInto_StridedDestination(t *testing.T) {
+	backing := &data.Vector[int]{Element: make([]int, 6)}
+	dst, _ := backing.Slice(0, 6, 2)
+	a := &data.Vector[int]{Element: []int{1, 2, 3}}
+
+	if err := vector.AddInto(dst, a, a); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []int{2, 0, 4, 0, 6, 0}
+	for i, val := range backing.Element {
+		if val != expected[i] {
+			t.Errorf("expected %d at index %d, got %d", expected[i], i, val)
+		}
+	}
+}
+
+func TestInto_MismatchedLengths(t *testing.T) {
+	a := &data.Vector[int]{Element: []int{1, 2, 3}}
+	dst := &data.Vector[int]{Element: make([]int, 2)}
+
+	if err := vector.SubInto(dst, a, a); err != vector.ErrMismatchedLengths {
+		t.Errorf("expected ErrMismatchedLengths, got: %v", err)
+	}
+}
+
+func TestDivInto_DivisionByZero(t *testing.T) {
+	a := &data.Vector[int]{Element: []int{10, 20, 30}}
+	b := &data.Vector[int]{Element: []int{2, 4, 0}}
+	dst := &data.Vector[int]{Element: []int{-1, -1, -1}}
+
+	if err := vector.DivInto(dst, a, b); err != vector.ErrDivisionByZero {
+		t.Fatalf("expected ErrDivisionByZero, got: %v", err)
+	}
+	for i, val := range dst.Element {
+		if val != -1 {
+			t.Errorf("dst modified at index %d despite error: %d", i, val)
+		}
+	}
+}
+
+func TestInto_ZeroAllocs(t *testing.T) {
+	a, _ := vector.CreateVector(make([]float64, 1000))
+	b, _ := vector.CreateVector(make([]float64, 1000))
+	dst, _ := vector.CreateVector(make([]float64, 1000))
+	for i := range b.Element {
+		b.Element[i] = 1
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		vector.AddInto(dst, a, b)
+		vector.SubInto(dst, a, b)
+		vector.MulInto(dst, a, b)
+		vector.DivInto(dst, a, b)
+		vector.ElementWiseMaxInto(dst, a, b)
+		vector.ElementWiseMinInto(dst, a, b)
+	})
+	if allocs != 0 {
+		t.Errorf("Into functions allocated %v times per run, want 0", allocs)
+	}
+}
+
+// Benchmark tests
+func BenchmarkAddInto(b *testing.B) {
+	vec1, _ := vector.CreateVector(make([]int, 1000))
+	vec2, _ := vector.CreateVector(make([]int, 1000))
+	dst, _ := vector.CreateVector(make([]int, 1000))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		vector.AddInto(dst, vec1, vec2)
+	}
+}
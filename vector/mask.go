@@ -0,0 +1,54 @@
+package vector
+
+import "github.com/wendersoon/gomathx/data"
+
+// Greater returns a mask that is true where a[i] > b[i].
+// Returns ErrMismatchedLengths if the vectors have different lengths.
+func Greater[T data.Number](a, b *data.Vector[T]) (*data.Mask, error) {
+	return compareVectors(a, b, func(x, y T) bool { return x > y })
+}
+
+// Less returns a mask that is true where a[i] < b[i].
+// Returns ErrMismatchedLengths if the vectors have different lengths.
+func Less[T data.Number](a, b *data.Vector[T]) (*data.Mask, error) {
+	return compareVectors(a, b, func(x, y T) bool { return x < y })
+}
+
+// Equal returns a mask that is true where a[i] == b[i]; NaNs never compare
+// equal. Returns ErrMismatchedLengths if the vectors have different lengths.
+func Equal[T data.Number](a, b *data.Vector[T]) (*data.Mask, error) {
+	return compareVectors(a, b, func(x, y T) bool { return x == y })
+}
+
+// Where returns a new vector holding a[i] where the mask is true and b[i]
+// where it is false. Returns ErrMismatchedLengths unless the mask and both
+// vectors have the same length.
+func Where[T data.Number](mask *data.Mask, a, b *data.Vector[T]) (*data.Vector[T], error) {
+	n := mask.Len()
+	if a.Len() != n || b.Len() != n {
+		return nil, ErrMismatchedLengths
+	}
+	result := make([]T, n)
+	for i := range result {
+		if mask.At(i) {
+			result[i] = a.At(i)
+		} else {
+			result[i] = b.At(i)
+		}
+	}
+	return &data.Vector[T]{Element: result}, nil
+}
+
+// compareVectors returns the mask of cmp applied to the elements of a and b
+func compareVectors[T data.Number](a, b *data.Vector[T], cmp func(x, y T) bool) (*data.Mask, error) {
+	if a.Len() != b.Len() {
+		return nil, ErrMismatchedLengths
+	}
+	mask := data.NewMask(a.Len())
+	for i, x := range a.All() {
+		if cmp(x, b.At(i)) {
+			mask.Set(i, true)
+		}
+	}
+	return mask, nil
+}
@@ -0,0 +1,78 @@
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/vector"
+)
+
+func TestVectorComparisons(t *testing.T) {
+	a, _ := vector.CreateVector([]int{1, 5, 3, 4})
+	b, _ := vector.CreateVector([]int{2, 5, 1, 0})
+
+	tests := []struct {
+		name     string
+		cmp      func(a, b *data.Vector[int]) (*data.Mask, error)
+		expected []int
+	}{
+		{"Greater", vector.Greater[int], []int{2, 3}},
+		{"Less", vector.Less[int], []int{0}},
+		{"Equal", vector.Equal[int], []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mask, err := tt.cmp(a, b)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			got := mask.Nonzero().Element
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected true at %v, got %v", tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("expected true at %v, got %v", tt.expected, got)
+				}
+			}
+		})
+	}
+
+	short, _ := vector.CreateVector([]int{1})
+	if _, err := vector.Greater(a, short); err != vector.ErrMismatchedLengths {
+		t.Errorf("expected ErrMismatchedLengths, got: %v", err)
+	}
+}
+
+func TestWhere(t *testing.T) {
+	a, _ := vector.CreateVector([]int{1, 2, 3, 4})
+	b, _ := vector.CreateVector([]int{-1, -2, -3, -4})
+
+	// Clip negatives of a - 3 to zero: where(x > 0, x, 0)
+	zeros, _ := vector.CreateVector([]int{0, 0, 0, 0})
+	shifted, _ := vector.SubVectors(a, &data.Vector[int]{Element: []int{3, 3, 3, 3}})
+	result, err := vector.Where(shifted.Greater(0), shifted, zeros)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected := []int{0, 0, 0, 1}
+	for i, val := range result.Element {
+		if val != expected[i] {
+			t.Errorf("expected %d at index %d, got %d", expected[i], i, val)
+		}
+	}
+
+	mask := data.MaskFromBools([]bool{true, false, true, false})
+	result, _ = vector.Where(mask, a, b)
+	expected = []int{1, -2, 3, -4}
+	for i, val := range result.Element {
+		if val != expected[i] {
+			t.Errorf("expected %d at index %d, got %d", expected[i], i, val)
+		}
+	}
+
+	if _, err := vector.Where(data.NewMask(3), a, b); err != vector.ErrMismatchedLengths {
+		t.Errorf("expected ErrMismatchedLengths, got: %v", err)
+	}
+}
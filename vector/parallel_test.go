@@ -0,0 +1,109 @@
+package vector_test
+
+import (
+	"math"
+	"runtime"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/parallel"
+	"github.com/wendersoon/gomathx/vector"
+)
+
+// largeVector returns a vector of n float64 values seeded by seed
+func largeVector(n int, seed float64) *data.Vector[float64] {
+	v := &data.Vector[float64]{Element: make([]float64, n)}
+	for i := range v.Element {
+		v.Element[i] = math.Sin(float64(i) + seed)
+	}
+	return v
+}
+
+func TestParallel_MatchesSerial(t *testing.T) {
+	a := &data.Vector[int]{Element: make([]int, 5000)}
+	b := &data.Vector[int]{Element: make([]int, 5000)}
+	for i := range a.Element {
+		a.Element[i] = i%17 - 8
+		b.Element[i] = i%11 + 1
+	}
+	wantDot, _ := vector.DotProduct(a, b)
+	wantDist, _ := vector.EuclideanDistance(a, b)
+	wantDiv, _ := vector.DivVectors(a, b)
+	wantMax, _ := vector.ElementWiseMax(a, b)
+
+	defer parallel.SetConfig(parallel.SetConfig(parallel.Config{Workers: 4, Threshold: 1, ChunkSize: 100}))
+
+	if got, _ := vector.DotProduct(a, b); got != wantDot {
+		t.Errorf("parallel DotProduct: expected %d, got %d", wantDot, got)
+	}
+	if got, _ := vector.EuclideanDistance(a, b); math.Abs(got-wantDist) > 1e-9 {
+		t.Errorf("parallel EuclideanDistance: expected %v, got %v", wantDist, got)
+	}
+	if got, _ := vector.DivVectors(a, b); !vector.EqualVectors(got, wantDiv) {
+		t.Error("parallel DivVectors differs from serial result")
+	}
+	if got, _ := vector.ElementWiseMax(a, b); !vector.EqualVectors(got, wantMax) {
+		t.Error("parallel ElementWiseMax differs from serial result")
+	}
+
+	// Strided views take the general path in each chunk
+	av, _ := a.Slice(0, 5000, 2)
+	bv, _ := b.Slice(1, 5000, 2)
+	sum, err := vector.AddVectors(av, bv)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for i := 0; i < sum.Len(); i++ {
+		if want := av.At(i) + bv.At(i); sum.At(i) != want {
+			t.Fatalf("parallel AddVectors on views: expected %d at index %d, got %d", want, i, sum.At(i))
+		}
+	}
+}
+
+func TestParallel_SerialPathZeroAllocs(t *testing.T) {
+	a := largeVector(1000, 0)
+	dst := largeVector(1000, 1)
+
+	defer parallel.SetConfig(parallel.SetConfig(parallel.Config{Workers: 4}))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		vector.AddInto(dst, a, a)
+		vector.DotProduct(a, a)
+	})
+	if allocs != 0 {
+		t.Errorf("operations below the threshold allocated %v times per run, want 0", allocs)
+	}
+}
+
+// Benchmark tests comparing serial and parallel execution
+func BenchmarkDotProductLarge(b *testing.B) {
+	x, y := largeVector(1<<22, 0), largeVector(1<<22, 1)
+
+	b.Run("serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			vector.DotProduct(x, y)
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		defer parallel.SetConfig(parallel.SetConfig(parallel.Config{Workers: runtime.GOMAXPROCS(0)}))
+		for i := 0; i < b.N; i++ {
+			vector.DotProduct(x, y)
+		}
+	})
+}
+
+func BenchmarkAddIntoLarge(b *testing.B) {
+	x, y, dst := largeVector(1<<22, 0), largeVector(1<<22, 1), largeVector(1<<22, 2)
+
+	b.Run("serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			vector.AddInto(dst, x, y)
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		defer parallel.SetConfig(parallel.SetConfig(parallel.Config{Workers: runtime.GOMAXPROCS(0)}))
+		for i := 0; i < b.N; i++ {
+			vector.AddInto(dst, x, y)
+		}
+	})
+}
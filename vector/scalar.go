@@ -0,0 +1,85 @@
+package vector
+
+import (
+	"math"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// The scalar functions are the counterparts of AddVectors, SubVectors and
+// DivVectors with one operand fixed; multiplication by a scalar is
+// data.Vector.Scale. They accept views and always return a new contiguous
+// vector.
+
+// AddScalar returns a new vector with s added to each element of v
+func AddScalar[T data.Number](v *data.Vector[T], s T) *data.Vector[T] {
+	result := make([]T, v.Len())
+	for i, x := range v.All() {
+		result[i] = x + s
+	}
+	return &data.Vector[T]{Element: result}
+}
+
+// SubScalar returns a new vector with s subtracted from each element of v
+func SubScalar[T data.Number](v *data.Vector[T], s T) *data.Vector[T] {
+	result := make([]T, v.Len())
+	for i, x := range v.All() {
+		result[i] = x - s
+	}
+	return &data.Vector[T]{Element: result}
+}
+
+// DivScalar returns a new vector with each element of v divided by s.
+// Integer division truncates toward zero, as in DivVectors.
+// Returns ErrDivisionByZero if s is zero.
+func DivScalar[T data.Number](v *data.Vector[T], s T) (*data.Vector[T], error) {
+	if s == 0 {
+		return nil, ErrDivisionByZero
+	}
+	result := make([]T, v.Len())
+	for i, x := range v.All() {
+		result[i] = x / s
+	}
+	return &data.Vector[T]{Element: result}, nil
+}
+
+// Pow returns a new vector with each element of v raised to the power p.
+// The result is float64 for every element type, following math.Pow, so
+// negative elements with a non-integer p give NaN.
+func Pow[T data.Number](v *data.Vector[T], p float64) *data.Vector[float64] {
+	result := make([]float64, v.Len())
+	for i, x := range v.All() {
+		result[i] = math.Pow(float64(x), p)
+	}
+	return &data.Vector[float64]{Element: result}
+}
+
+// Clip returns a new vector with each element of v limited to [lo, hi].
+// NaNs are kept. As in NumPy, every element becomes hi when lo > hi.
+func Clip[T data.Number](v *data.Vector[T], lo, hi T) *data.Vector[T] {
+	result := make([]T, v.Len())
+	for i, x := range v.All() {
+		if x < lo {
+			x = lo
+		}
+		if x > hi {
+			x = hi
+		}
+		result[i] = x
+	}
+	return &data.Vector[T]{Element: result}
+}
+
+// broadcastLen returns the length of the result of a binary operation on a
+// and b: their common length, or the length of the other vector when one of
+// them has a single element. Returns ErrMismatchedLengths otherwise.
+func broadcastLen[T data.Number](a, b *data.Vector[T]) (int, error) {
+	switch na, nb := a.Len(), b.Len(); {
+	case na == nb, nb == 1:
+		return na, nil
+	case na == 1:
+		return nb, nil
+	default:
+		return 0, ErrMismatchedLengths
+	}
+}
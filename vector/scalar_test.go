@@ -0,0 +1,116 @@
+package vector_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/vector"
+)
+
+func TestScalarArithmetic(t *testing.T) {
+	v, _ := vector.CreateVector([]int{4, -6, 9})
+
+	if got := vector.AddScalar(v, 2); !reflect.DeepEqual(got.Element, []int{6, -4, 11}) {
+		t.Errorf("AddScalar() = %v, want [6 -4 11]", got.Element)
+	}
+	if got := vector.SubScalar(v, 2); !reflect.DeepEqual(got.Element, []int{2, -8, 7}) {
+		t.Errorf("SubScalar() = %v, want [2 -8 7]", got.Element)
+	}
+	got, err := vector.DivScalar(v, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Element, []int{1, -1, 2}) {
+		t.Errorf("DivScalar() = %v, want [1 -1 2]", got.Element)
+	}
+	if _, err := vector.DivScalar(v, 0); err != vector.ErrDivisionByZero {
+		t.Errorf("DivScalar(0) error = %v, want ErrDivisionByZero", err)
+	}
+	if !reflect.DeepEqual(v.Element, []int{4, -6, 9}) {
+		t.Errorf("Input modified: %v", v.Element)
+	}
+
+	// Views are read through their stride
+	parent := &data.Vector[int]{Element: []int{1, 0, 2, 0, 3}}
+	view, _ := parent.Slice(0, 5, 2)
+	if got := vector.AddScalar(view, 10); !reflect.DeepEqual(got.Element, []int{11, 12, 13}) {
+		t.Errorf("AddScalar() on view = %v, want [11 12 13]", got.Element)
+	}
+}
+
+func TestPow(t *testing.T) {
+	v, _ := vector.CreateVector([]float64{4, 9, -1})
+	got := vector.Pow(v, 0.5)
+	if got.Element[0] != 2 || got.Element[1] != 3 || !math.IsNaN(got.Element[2]) {
+		t.Errorf("Pow(0.5) = %v, want [2 3 NaN]", got.Element)
+	}
+
+	ints, _ := vector.CreateVector([]int{2, 3})
+	if got := vector.Pow(ints, 3); !reflect.DeepEqual(got.Element, []float64{8, 27}) {
+		t.Errorf("Pow(3) = %v, want [8 27]", got.Element)
+	}
+}
+
+func TestClip(t *testing.T) {
+	v, _ := vector.CreateVector([]float64{-5, 0.5, 7, math.NaN()})
+	got := vector.Clip(v, 0, 1)
+	if got.Element[0] != 0 || got.Element[1] != 0.5 || got.Element[2] != 1 || !math.IsNaN(got.Element[3]) {
+		t.Errorf("Clip(0, 1) = %v, want [0 0.5 1 NaN]", got.Element)
+	}
+
+	// lo > hi gives hi everywhere, as in NumPy
+	ints, _ := vector.CreateVector([]int{-1, 5, 10})
+	if got := vector.Clip(ints, 6, 2); !reflect.DeepEqual(got.Element, []int{2, 2, 2}) {
+		t.Errorf("Clip(6, 2) = %v, want [2 2 2]", got.Element)
+	}
+}
+
+func TestBroadcasting(t *testing.T) {
+	v, _ := vector.CreateVector([]int{10, 20, 30})
+	one, _ := vector.CreateVector([]int{5})
+
+	tests := []struct {
+		name     string
+		op       func(a, b *data.Vector[int]) (*data.Vector[int], error)
+		a, b     *data.Vector[int]
+		expected []int
+	}{
+		{"Add right", func(a, b *data.Vector[int]) (*data.Vector[int], error) { return vector.AddVectors(a, b) }, v, one, []int{15, 25, 35}},
+		{"Add left", func(a, b *data.Vector[int]) (*data.Vector[int], error) { return vector.AddVectors(a, b) }, one, v, []int{15, 25, 35}},
+		{"Sub right", vector.SubVectors[int], v, one, []int{5, 15, 25}},
+		{"Sub left", vector.SubVectors[int], one, v, []int{-5, -15, -25}},
+		{"Mul right", vector.MulVectors[int], v, one, []int{50, 100, 150}},
+		{"Mul left", vector.MulVectors[int], one, v, []int{50, 100, 150}},
+		{"Div right", vector.DivVectors[int], v, one, []int{2, 4, 6}},
+		{"Div left", vector.DivVectors[int], &data.Vector[int]{Element: []int{60}}, v, []int{6, 3, 2}},
+		{"Both single", vector.SubVectors[int], one, one, []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got.Element, tt.expected) {
+				t.Errorf("got %v, want %v", got.Element, tt.expected)
+			}
+		})
+	}
+
+	sum, err := vector.AddVectors(one, v, one, v)
+	if err != nil || !reflect.DeepEqual(sum.Element, []int{30, 50, 70}) {
+		t.Errorf("AddVectors(one, v, one, v) = %v, %v, want [30 50 70]", sum, err)
+	}
+
+	short, _ := vector.CreateVector([]int{1, 2})
+	if _, err := vector.AddVectors(v, one, short); err != vector.ErrMismatchedLengths {
+		t.Errorf("AddVectors() error = %v, want ErrMismatchedLengths", err)
+	}
+	zeros, _ := vector.CreateVector([]int{1, 0, 2})
+	if _, err := vector.DivVectors(one, zeros); err != vector.ErrDivisionByZero {
+		t.Errorf("DivVectors() error = %v, want ErrDivisionByZero", err)
+	}
+}
@@ -0,0 +1,148 @@
+package vector
+
+import (
+	"errors"
+	"math"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// CreateSparseVector creates a new sparse vector of dimension dim holding
+// values at the given indices. Indices must be strictly increasing and
+// within [0, dim). Zero values are dropped from the result.
+func CreateSparseVector[T data.Number](dim int, indices []int, values []T) (*data.SparseVector[T], error) {
+	if dim <= 0 {
+		return nil, ErrEmptyVector
+	}
+	if len(indices) != len(values) {
+		return nil, ErrMismatchedLengths
+	}
+	sv := &data.SparseVector[T]{Dim: dim}
+	for k, idx := range indices {
+		if idx < 0 || idx >= dim || (k > 0 && idx <= indices[k-1]) {
+			return nil, ErrInvalidIndices
+		}
+		if values[k] != 0 {
+			sv.Indices = append(sv.Indices, idx)
+			sv.Values = append(sv.Values, values[k])
+		}
+	}
+	return sv, nil
+}
+
+// AddSparseVectors performs element-wise addition on two or more sparse vectors.
+// All vectors must have the same dimension. Returns an error if fewer than two
+// vectors are provided or if their dimensions do not match.
+func AddSparseVectors[T data.Number](vectors ...*data.SparseVector[T]) (*data.SparseVector[T], error) {
+	if len(vectors) < 2 {
+		return nil, errors.New("need at least two vectors to add")
+	}
+	dim := vectors[0].Dim
+	for _, v := range vectors {
+		if v.Dim != dim {
+			return nil, ErrMismatchedLengths
+		}
+	}
+
+	result := vectors[0]
+	for _, v := range vectors[1:] {
+		result = mergeSparse(result, v)
+	}
+	return result, nil
+}
+
+// mergeSparse returns a + b, dropping entries that cancel to zero
+func mergeSparse[T data.Number](a, b *data.SparseVector[T]) *data.SparseVector[T] {
+	result := &data.SparseVector[T]{
+		Dim:     a.Dim,
+		Indices: make([]int, 0, a.Nnz()+b.Nnz()),
+		Values:  make([]T, 0, a.Nnz()+b.Nnz()),
+	}
+	push := func(idx int, val T) {
+		if val != 0 {
+			result.Indices = append(result.Indices, idx)
+			result.Values = append(result.Values, val)
+		}
+	}
+
+	i, j := 0, 0
+	for i < a.Nnz() && j < b.Nnz() {
+		switch {
+		case a.Indices[i] < b.Indices[j]:
+			push(a.Indices[i], a.Values[i])
+			i++
+		case a.Indices[i] > b.Indices[j]:
+			push(b.Indices[j], b.Values[j])
+			j++
+		default:
+			push(a.Indices[i], a.Values[i]+b.Values[j])
+			i++
+			j++
+		}
+	}
+	for ; i < a.Nnz(); i++ {
+		push(a.Indices[i], a.Values[i])
+	}
+	for ; j < b.Nnz(); j++ {
+		push(b.Indices[j], b.Values[j])
+	}
+	return result
+}
+
+// SparseDotProduct computes the dot product of two sparse vectors.
+// Returns an error if the vectors have different dimensions.
+func SparseDotProduct[T data.Number](a, b *data.SparseVector[T]) (T, error) {
+	if a.Dim != b.Dim {
+		return 0, ErrMismatchedLengths
+	}
+	var result T
+	i, j := 0, 0
+	for i < a.Nnz() && j < b.Nnz() {
+		switch {
+		case a.Indices[i] < b.Indices[j]:
+			i++
+		case a.Indices[i] > b.Indices[j]:
+			j++
+		default:
+			result += a.Values[i] * b.Values[j]
+			i++
+			j++
+		}
+	}
+	return result, nil
+}
+
+// SparseCosineSimilarity calculates the cosine similarity between two sparse vectors.
+// Returns a value between -1 and 1, or an error on invalid input.
+func SparseCosineSimilarity[T data.Number](a, b *data.SparseVector[T]) (float64, error) {
+	if a.Dim != b.Dim {
+		return 0, ErrMismatchedLengths
+	}
+
+	var dot, normA, normB float64
+	for _, val := range a.Values {
+		normA += float64(val) * float64(val)
+	}
+	for _, val := range b.Values {
+		normB += float64(val) * float64(val)
+	}
+	i, j := 0, 0
+	for i < a.Nnz() && j < b.Nnz() {
+		switch {
+		case a.Indices[i] < b.Indices[j]:
+			i++
+		case a.Indices[i] > b.Indices[j]:
+			j++
+		default:
+			dot += float64(a.Values[i]) * float64(b.Values[j])
+			i++
+			j++
+		}
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0, errors.New("cosine similarity undefined for zero-length vector")
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB)), nil
+}
@@ -0,0 +1,173 @@
+package vector_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/wendersoon/gomathx/vector"
+)
+
+// TestCreateSparseVector tests the CreateSparseVector function
+func TestCreateSparseVector_Success(t *testing.T) {
+	sv, err := vector.CreateSparseVector(10, []int{1, 4, 7}, []float64{1.5, 0, -2})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if sv.Len() != 10 {
+		t.Errorf("expected dimension 10, got: %d", sv.Len())
+	}
+
+	// Explicit zeros are not stored
+	if !reflect.DeepEqual(sv.Indices, []int{1, 7}) {
+		t.Errorf("expected indices [1 7], got %v", sv.Indices)
+	}
+}
+
+func TestCreateSparseVector_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		dim      int
+		indices  []int
+		values   []int
+		expected error
+	}{
+		{"Zero dimension", 0, nil, nil, vector.ErrEmptyVector},
+		{"Length mismatch", 5, []int{1, 2}, []int{1}, vector.ErrMismatchedLengths},
+		{"Unsorted", 5, []int{2, 1}, []int{1, 1}, vector.ErrInvalidIndices},
+		{"Duplicate", 5, []int{1, 1}, []int{1, 1}, vector.ErrInvalidIndices},
+		{"Out of range", 5, []int{1, 5}, []int{1, 1}, vector.ErrInvalidIndices},
+		{"Negative", 5, []int{-1}, []int{1}, vector.ErrInvalidIndices},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv, err := vector.CreateSparseVector(tt.dim, tt.indices, tt.values)
+			if err != tt.expected {
+				t.Errorf("expected %v, got: %v", tt.expected, err)
+			}
+			if sv != nil {
+				t.Errorf("expected nil vector, got: %+v", sv)
+			}
+		})
+	}
+}
+
+// TestAddSparseVectors tests the AddSparseVectors function
+func TestAddSparseVectors_Success(t *testing.T) {
+	a, _ := vector.CreateSparseVector(8, []int{0, 3, 5}, []int{1, 2, 3})
+	b, _ := vector.CreateSparseVector(8, []int{3, 6}, []int{-2, 4})
+	c, _ := vector.CreateSparseVector(8, []int{0, 7}, []int{1, 1})
+
+	result, err := vector.AddSparseVectors(a, b, c)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// Index 3 cancels out and must not be stored
+	if !reflect.DeepEqual(result.Indices, []int{0, 5, 6, 7}) {
+		t.Errorf("expected indices [0 5 6 7], got %v", result.Indices)
+	}
+	if !reflect.DeepEqual(result.Values, []int{2, 3, 4, 1}) {
+		t.Errorf("expected values [2 3 4 1], got %v", result.Values)
+	}
+}
+
+func TestAddSparseVectors_MatchesDense(t *testing.T) {
+	a, _ := vector.CreateSparseVector(6, []int{1, 2, 4}, []int{5, -1, 2})
+	b, _ := vector.CreateSparseVector(6, []int{0, 2, 5}, []int{3, 4, 1})
+
+	sparse, _ := vector.AddSparseVectors(a, b)
+	dense, _ := vector.AddVectors(a.ToDense(), b.ToDense())
+
+	if !reflect.DeepEqual(sparse.ToDense().Element, dense.Element) {
+		t.Errorf("expected %v, got %v", dense.Element, sparse.ToDense().Element)
+	}
+}
+
+func TestAddSparseVectors_Errors(t *testing.T) {
+	a, _ := vector.CreateSparseVector(4, []int{1}, []int{1})
+	b, _ := vector.CreateSparseVector(5, []int{1}, []int{1})
+
+	if _, err := vector.AddSparseVectors(a); err == nil {
+		t.Error("expected error for single vector")
+	}
+	if _, err := vector.AddSparseVectors(a, b); err != vector.ErrMismatchedLengths {
+		t.Errorf("expected ErrMismatchedLengths, got: %v", err)
+	}
+}
+
+// TestSparseDotProduct tests the SparseDotProduct function
+func TestSparseDotProduct_MatchesDense(t *testing.T) {
+	a, _ := vector.CreateSparseVector(100000, []int{3, 500, 99999}, []float64{1.5, 2, -1})
+	b, _ := vector.CreateSparseVector(100000, []int{500, 777, 99999}, []float64{4, 9, 3})
+
+	result, err := vector.SparseDotProduct(a, b)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected, _ := vector.DotProduct(a.ToDense(), b.ToDense())
+	if result != expected {
+		t.Errorf("expected %f, got %f", expected, result)
+	}
+}
+
+func TestSparseDotProduct_MismatchedLengths(t *testing.T) {
+	a, _ := vector.CreateSparseVector(4, []int{1}, []int{1})
+	b, _ := vector.CreateSparseVector(5, []int{1}, []int{1})
+
+	_, err := vector.SparseDotProduct(a, b)
+
+	if err != vector.ErrMismatchedLengths {
+		t.Errorf("expected ErrMismatchedLengths, got: %v", err)
+	}
+}
+
+// TestSparseCosineSimilarity tests the SparseCosineSimilarity function
+func TestSparseCosineSimilarity_MatchesDense(t *testing.T) {
+	a, _ := vector.CreateSparseVector(6, []int{0, 2, 4}, []int{1, 2, 3})
+	b, _ := vector.CreateSparseVector(6, []int{0, 1, 4}, []int{4, 5, 6})
+
+	result, err := vector.SparseCosineSimilarity(a, b)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected, _ := vector.CosineSimilarity(a.ToDense(), b.ToDense())
+	if math.Abs(result-expected) > 1e-12 {
+		t.Errorf("expected %f, got %f", expected, result)
+	}
+}
+
+func TestSparseCosineSimilarity_ZeroVector(t *testing.T) {
+	a, _ := vector.CreateSparseVector(3, nil, []int{})
+	b, _ := vector.CreateSparseVector(3, []int{1}, []int{2})
+
+	_, err := vector.SparseCosineSimilarity(a, b)
+
+	expectedMsg := "cosine similarity undefined for zero-length vector"
+	if err == nil || err.Error() != expectedMsg {
+		t.Errorf("expected error message '%s', got '%v'", expectedMsg, err)
+	}
+}
+
+// Benchmark tests
+func BenchmarkSparseDotProduct(b *testing.B) {
+	indices := make([]int, 1000)
+	values := make([]float64, 1000)
+	for i := range indices {
+		indices[i] = i * 100
+		values[i] = float64(i)
+	}
+	a, _ := vector.CreateSparseVector(100000, indices, values)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		vector.SparseDotProduct(a, a)
+	}
+}
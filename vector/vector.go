@@ -5,6 +5,7 @@ import (
 	"math"
 
 	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/parallel"
 )
 
 // CreateVector creates a new generic vector
@@ -16,69 +17,111 @@ func CreateVector[T data.Number](slice []T) (*data.Vector[T], error) {
 }
 
 // AddVectors performs element-wise addition on two or more vectors.
-// All vectors must have the same length. Returns an error if fewer than two vectors
-// are provided or if their lengths do not match.func AddVectors[T data.Number](vectors ...*data.Vector[T]) (*data.Vector[T], error) {
+// All vectors must have the same length, except that vectors with a single
+// element are broadcast to the common length. Returns an error if fewer than
+// two vectors are provided or if their lengths do not match.
 func AddVectors[T data.Number](vectors ...*data.Vector[T]) (*data.Vector[T], error) {
 
 	if len(vectors) < 2 {
 		return nil, errors.New("need at least two vectors to add")
 	}
-	length := vectors[0].Len()
+	length := 1
 	for _, v := range vectors {
-		if v.Len() != length {
-			return nil, ErrMismatchedLengths
+		if n := v.Len(); n != 1 {
+			if length != 1 && n != length {
+				return nil, ErrMismatchedLengths
+			}
+			length = n
 		}
 	}
 
-	result := make([]T, length)
+	result := &data.Vector[T]{Element: make([]T, length)}
 	for _, v := range vectors {
-		for i, val := range v.Element {
-			result[i] += val
+		if v.Len() == length {
+			AddInto(result, result, v)
+			continue
+		}
+		s := v.At(0)
+		for i := range result.Element {
+			result.Element[i] += s
 		}
 	}
-	return &data.Vector[T]{Element: result}, nil
+	return result, nil
 }
 
 // SubVectors performs element-wise subtraction between two vectors (a - b).
-// Returns an error if the vectors have different lengths.
+// A vector with a single element is broadcast to the length of the other.
+// Returns an error if the vectors have different lengths otherwise.
 func SubVectors[T data.Number](a, b *data.Vector[T]) (*data.Vector[T], error) {
-	if a.Len() != b.Len() {
-		return nil, ErrMismatchedLengths
+	n, err := broadcastLen(a, b)
+	if err != nil {
+		return nil, err
 	}
-	result := make([]T, a.Len())
-	for i := 0; i < a.Len(); i++ {
-		result[i] = a.Element[i] - b.Element[i]
+	switch {
+	case a.Len() != b.Len() && b.Len() == 1:
+		return SubScalar(a, b.At(0)), nil
+	case a.Len() != b.Len():
+		s, result := a.At(0), make([]T, n)
+		for i, x := range b.All() {
+			result[i] = s - x
+		}
+		return &data.Vector[T]{Element: result}, nil
+	}
+	result := &data.Vector[T]{Element: make([]T, n)}
+	if err := SubInto(result, a, b); err != nil {
+		return nil, err
 	}
-	return &data.Vector[T]{Element: result}, nil
+	return result, nil
 }
 
 // MulVectors performs element-wise multiplication between two vectors.
-// Returns an error if the vectors have different lengths.
+// A vector with a single element is broadcast to the length of the other.
+// Returns an error if the vectors have different lengths otherwise.
 func MulVectors[T data.Number](a, b *data.Vector[T]) (*data.Vector[T], error) {
-	if a.Len() != b.Len() {
-		return nil, ErrMismatchedLengths
+	n, err := broadcastLen(a, b)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case a.Len() != b.Len() && b.Len() == 1:
+		return a.Scale(b.At(0)), nil
+	case a.Len() != b.Len():
+		return b.Scale(a.At(0)), nil
 	}
-	result := make([]T, a.Len())
-	for i := 0; i < a.Len(); i++ {
-		result[i] = a.Element[i] * b.Element[i]
+	result := &data.Vector[T]{Element: make([]T, n)}
+	if err := MulInto(result, a, b); err != nil {
+		return nil, err
 	}
-	return &data.Vector[T]{Element: result}, nil
+	return result, nil
 }
 
 // DivVectors performs element-wise division between two vectors (a / b).
-// Returns an error if the vectors have different lengths or if any element in b is zero.
+// A vector with a single element is broadcast to the length of the other.
+// Returns an error if the vectors have different lengths otherwise or if any
+// element in b is zero.
 func DivVectors[T data.Number](a, b *data.Vector[T]) (*data.Vector[T], error) {
-	if a.Len() != b.Len() {
-		return nil, ErrMismatchedLengths
+	n, err := broadcastLen(a, b)
+	if err != nil {
+		return nil, err
 	}
-	result := make([]T, a.Len())
-	for i := 0; i < a.Len(); i++ {
-		if b.Element[i] == 0 {
-			return nil, errors.New("division by zero")
+	switch {
+	case a.Len() != b.Len() && b.Len() == 1:
+		return DivScalar(a, b.At(0))
+	case a.Len() != b.Len():
+		s, result := a.At(0), make([]T, n)
+		for i, x := range b.All() {
+			if x == 0 {
+				return nil, ErrDivisionByZero
+			}
+			result[i] = s / x
 		}
-		result[i] = a.Element[i] / b.Element[i]
+		return &data.Vector[T]{Element: result}, nil
 	}
-	return &data.Vector[T]{Element: result}, nil
+	result := &data.Vector[T]{Element: make([]T, n)}
+	if err := DivInto(result, a, b); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // DotProduct computes the dot product (scalar product) of two vectors.
@@ -87,25 +130,95 @@ func DotProduct[T data.Number](a, b *data.Vector[T]) (T, error) {
 	if a.Len() != b.Len() {
 		return 0, ErrMismatchedLengths
 	}
+	if n := a.Len(); parallel.Active(n) {
+		return parallel.Reduce(n, func(lo, hi int) T { return dotRange(a, b, lo, hi) }, add[T]), nil
+	}
+	return dotRange(a, b, 0, a.Len()), nil
+}
+
+// DotProductWith computes the dot product of two vectors, accumulating the
+// products with the given summation method. Only the additions are
+// compensated; each product is still rounded to T.
+// Returns an error if vectors have different lengths.
+func DotProductWith[T data.Number](a, b *data.Vector[T], method data.SumMethod) (T, error) {
+	if a.Len() != b.Len() {
+		return 0, ErrMismatchedLengths
+	}
+	ae, be, sa, sb := a.Element, b.Element, stride(a), stride(b)
+	return data.SumFunc(a.Len(), func(i int) T { return ae[i*sa] * be[i*sb] }, method), nil
+}
+
+// CheckedDotProduct computes the dot product of two vectors, returning a
+// *data.OverflowError identifying the index at which a product or the running
+// sum overflowed the element type.
+// Returns an error if vectors have different lengths.
+func CheckedDotProduct[T data.Number](a, b *data.Vector[T]) (T, error) {
+	if a.Len() != b.Len() {
+		return 0, ErrMismatchedLengths
+	}
+	n, sa, sb := a.Len(), stride(a), stride(b)
 	var result T
-	for i := 0; i < a.Len(); i++ {
-		result += a.Element[i] * b.Element[i]
+	for i := 0; i < n; i++ {
+		p, ok := data.CheckedMul(a.Element[i*sa], b.Element[i*sb])
+		if ok {
+			result, ok = data.CheckedAdd(result, p)
+		}
+		if !ok {
+			return 0, &data.OverflowError{Op: "dot product", Index: i}
+		}
 	}
 	return result, nil
 }
 
+// dotRange returns the dot product of the elements with index in [lo, hi)
+func dotRange[T data.Number](a, b *data.Vector[T], lo, hi int) T {
+	sa, sb := stride(a), stride(b)
+	var result T
+	if sa == 1 && sb == 1 {
+		// Contiguous fast path: plain slice indexing lets the compiler drop bounds checks
+		ae, be := a.Element[lo:hi], b.Element[lo:hi]
+		for i := range ae {
+			result += ae[i] * be[i]
+		}
+		return result
+	}
+	for i := lo; i < hi; i++ {
+		result += a.Element[i*sa] * b.Element[i*sb]
+	}
+	return result
+}
+
 // EuclideanDistance calculates the Euclidean distance between two vectors.
 // Returns an error if vectors have different lengths.
 func EuclideanDistance[T data.Number](a, b *data.Vector[T]) (float64, error) {
 	if a.Len() != b.Len() {
 		return 0, ErrMismatchedLengths
 	}
+	if n := a.Len(); parallel.Active(n) {
+		sum := parallel.Reduce(n, func(lo, hi int) float64 { return squaredDistRange(a, b, lo, hi) }, add[float64])
+		return math.Sqrt(sum), nil
+	}
+	return math.Sqrt(squaredDistRange(a, b, 0, a.Len())), nil
+}
+
+// squaredDistRange returns the squared distance between the elements with
+// index in [lo, hi)
+func squaredDistRange[T data.Number](a, b *data.Vector[T], lo, hi int) float64 {
+	sa, sb := stride(a), stride(b)
 	var sum float64
-	for i := 0; i < a.Len(); i++ {
-		diff := float64(a.Element[i] - b.Element[i])
+	if sa == 1 && sb == 1 {
+		ae, be := a.Element[lo:hi], b.Element[lo:hi]
+		for i := range ae {
+			diff := float64(ae[i] - be[i])
+			sum += diff * diff
+		}
+		return sum
+	}
+	for i := lo; i < hi; i++ {
+		diff := float64(a.Element[i*sa] - b.Element[i*sb])
 		sum += diff * diff
 	}
-	return math.Sqrt(sum), nil
+	return sum
 }
 
 // CosineSimilarity calculates the cosine similarity between two vectors.
@@ -114,11 +227,12 @@ func CosineSimilarity[T data.Number](a, b *data.Vector[T]) (float64, error) {
 	if a.Len() != b.Len() {
 		return 0, ErrMismatchedLengths
 	}
+	n, sa, sb := a.Len(), stride(a), stride(b)
 
 	var dot, normA, normB float64
-	for i := 0; i < a.Len(); i++ {
-		ai := float64(a.Element[i])
-		bi := float64(b.Element[i])
+	for i := 0; i < n; i++ {
+		ai := float64(a.Element[i*sa])
+		bi := float64(b.Element[i*sb])
 		dot += ai * bi
 		normA += ai * ai
 		normB += bi * bi
@@ -137,8 +251,9 @@ func EqualVectors[T data.Number](a, b *data.Vector[T]) bool {
 	if a.Len() != b.Len() {
 		return false
 	}
-	for i := 0; i < a.Len(); i++ {
-		if a.Element[i] != b.Element[i] {
+	n, sa, sb := a.Len(), stride(a), stride(b)
+	for i := 0; i < n; i++ {
+		if a.Element[i*sa] != b.Element[i*sb] {
 			return false
 		}
 	}
@@ -150,15 +265,11 @@ func ElementWiseMax[T data.Number](a, b *data.Vector[T]) (*data.Vector[T], error
 	if a.Len() != b.Len() {
 		return nil, ErrMismatchedLengths
 	}
-	result := make([]T, a.Len())
-	for i := 0; i < a.Len(); i++ {
-		if a.Element[i] > b.Element[i] {
-			result[i] = a.Element[i]
-		} else {
-			result[i] = b.Element[i]
-		}
+	result := &data.Vector[T]{Element: make([]T, a.Len())}
+	if err := ElementWiseMaxInto(result, a, b); err != nil {
+		return nil, err
 	}
-	return &data.Vector[T]{Element: result}, nil
+	return result, nil
 }
 
 // ElementWiseMin returns a vector containing the element-wise minimum values of two vectors.
@@ -166,13 +277,19 @@ func ElementWiseMin[T data.Number](a, b *data.Vector[T]) (*data.Vector[T], error
 	if a.Len() != b.Len() {
 		return nil, ErrMismatchedLengths
 	}
-	result := make([]T, a.Len())
-	for i := 0; i < a.Len(); i++ {
-		if a.Element[i] < b.Element[i] {
-			result[i] = a.Element[i]
-		} else {
-			result[i] = b.Element[i]
-		}
+	result := &data.Vector[T]{Element: make([]T, a.Len())}
+	if err := ElementWiseMinInto(result, a, b); err != nil {
+		return nil, err
 	}
-	return &data.Vector[T]{Element: result}, nil
+	return result, nil
+}
+
+// stride returns the distance in Element between consecutive elements of v
+func stride[T data.Number](v *data.Vector[T]) int {
+	return max(v.Stride, 1)
+}
+
+// add returns x + y, for use as the combine step of parallel reductions
+func add[T data.Number](x, y T) T {
+	return x + y
 }
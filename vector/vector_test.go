@@ -1,6 +1,7 @@
 package vector_test
 
 import (
+	"errors"
 	"math"
 	"testing"
 
@@ -645,3 +646,93 @@ func BenchmarkEuclideanDistance(b *testing.B) {
 		vector.EuclideanDistance(vec1, vec2)
 	}
 }
+
+// TestStridedViews tests that vector functions accept views
+func TestStridedViews_DotProductAndAdd(t *testing.T) {
+	backing, _ := vector.CreateVector([]int{1, 10, 2, 20, 3, 30})
+	odd, _ := backing.Slice(0, 6, 2)  // [1, 2, 3]
+	even, _ := backing.Slice(1, 6, 2) // [10, 20, 30]
+
+	dot, err := vector.DotProduct(odd, even)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if dot != 140 {
+		t.Errorf("expected 140, got %d", dot)
+	}
+
+	sum, err := vector.AddVectors(odd, even, odd)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected := []int{12, 24, 36}
+	for i, val := range sum.Element {
+		if val != expected[i] {
+			t.Errorf("expected %d at index %d, got %d", expected[i], i, val)
+		}
+	}
+
+	if !vector.EqualVectors(odd, &data.Vector[int]{Element: []int{1, 2, 3}}) {
+		t.Error("expected view to equal its contiguous copy")
+	}
+}
+
+func TestStridedViews_MismatchedLengths(t *testing.T) {
+	backing, _ := vector.CreateVector([]int{1, 2, 3, 4, 5})
+	a, _ := backing.Slice(0, 5, 2) // 3 elements
+	b, _ := backing.Slice(1, 5, 2) // 2 elements
+
+	if _, err := vector.SubVectors(a, b); err != vector.ErrMismatchedLengths {
+		t.Errorf("expected ErrMismatchedLengths, got: %v", err)
+	}
+}
+
+func TestDotProductWith(t *testing.T) {
+	a, _ := vector.CreateVector([]float64{1, 1e100, 1, -1e100})
+	b, _ := vector.CreateVector([]float64{1, 1, 1, 1})
+
+	naive, _ := vector.DotProductWith(a, b, data.SumNaive)
+	if naive != 0 {
+		t.Errorf("expected naive dot product 0, got %v", naive)
+	}
+	got, err := vector.DotProductWith(a, b, data.SumKahan)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got != 2 {
+		t.Errorf("expected compensated dot product 2, got %v", got)
+	}
+
+	short, _ := vector.CreateVector([]float64{1})
+	if _, err := vector.DotProductWith(a, short, data.SumPairwise); err != vector.ErrMismatchedLengths {
+		t.Errorf("expected ErrMismatchedLengths, got: %v", err)
+	}
+}
+
+func TestCheckedDotProduct(t *testing.T) {
+	a, _ := vector.CreateVector([]int8{10, 10, 2})
+	b, _ := vector.CreateVector([]int8{5, 7, 1})
+
+	got, err := vector.CheckedDotProduct(a, b)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got != 122 {
+		t.Errorf("expected 122, got %d", got)
+	}
+
+	// 10*5 + 10*7 = 120, then 120 + 2*5 overflows int8
+	c, _ := vector.CreateVector([]int8{5, 7, 5})
+	_, err = vector.CheckedDotProduct(a, c)
+	var overflow *data.OverflowError
+	if !errors.As(err, &overflow) || overflow.Index != 2 {
+		t.Errorf("expected overflow at index 2, got: %v", err)
+	}
+
+	// The product itself overflows
+	d, _ := vector.CreateVector([]int8{100, 0, 0})
+	_, err = vector.CheckedDotProduct(a, d)
+	if !errors.As(err, &overflow) || overflow.Index != 0 {
+		t.Errorf("expected overflow at index 0, got: %v", err)
+	}
+}
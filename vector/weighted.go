@@ -0,0 +1,147 @@
+package vector
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/wendersoon/gomathx/data"
+)
+
+// The weighted statistics take one non-negative weight per element. They
+// return ErrMismatchedLengths if the lengths differ, ErrNegativeWeight if a
+// weight is negative or NaN, and ErrZeroWeights if every weight is zero.
+
+// WeightedMean returns sum(w*x) / sum(w)
+func WeightedMean[T data.Number](v *data.Vector[T], weights *data.Vector[float64]) (float64, error) {
+	total, err := weightTotal(v, weights)
+	if err != nil {
+		return 0, err
+	}
+	var sum float64
+	for i, x := range v.All() {
+		sum += weights.At(i) * float64(x)
+	}
+	return sum / total, nil
+}
+
+// WeightedVariance returns sum(w*(x-mean)^2) / sum(w), where mean is the
+// weighted mean. Like Vector.StdDev this is the population form; with integer
+// frequency weights it equals the variance of the expanded data.
+func WeightedVariance[T data.Number](v *data.Vector[T], weights *data.Vector[float64]) (float64, error) {
+	mean, err := WeightedMean(v, weights)
+	if err != nil {
+		return 0, err
+	}
+	var sum, total float64
+	for i, x := range v.All() {
+		d := float64(x) - mean
+		sum += weights.At(i) * d * d
+		total += weights.At(i)
+	}
+	return sum / total, nil
+}
+
+// WeightedStdDev returns the square root of WeightedVariance
+func WeightedStdDev[T data.Number](v *data.Vector[T], weights *data.Vector[float64]) (float64, error) {
+	variance, err := WeightedVariance(v, weights)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
+
+// WeightedMedian returns the weighted median, WeightedQuantiles at 0.5
+func WeightedMedian[T data.Number](v *data.Vector[T], weights *data.Vector[float64]) (float64, error) {
+	qs, err := WeightedQuantiles(v, weights, 0.5)
+	if err != nil {
+		return 0, err
+	}
+	return qs.Element[0], nil
+}
+
+// WeightedQuantiles returns the weighted quantiles qs, each in [0, 1], in the
+// order given. The q-th quantile is the smallest element whose cumulative
+// weight reaches q times the total weight, which matches NumPy's quantile
+// with weights and method "inverted_cdf". With equal weights it therefore
+// returns an element of v and does not interpolate: the weighted median of
+// [1 2 3 4] is 2. Elements with zero weight are never returned.
+func WeightedQuantiles[T data.Number](v *data.Vector[T], weights *data.Vector[float64], qs ...float64) (*data.Vector[float64], error) {
+	total, err := weightTotal(v, weights)
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range qs {
+		if !(q >= 0 && q <= 1) {
+			return nil, data.ErrInvalidQuantile
+		}
+	}
+
+	order := make([]int, 0, v.Len())
+	for i := range v.Len() {
+		if weights.At(i) > 0 {
+			order = append(order, i)
+		}
+	}
+	slices.SortStableFunc(order, func(i, j int) int { return cmp.Compare(v.At(i), v.At(j)) })
+
+	cumulative := make([]float64, len(order))
+	var sum float64
+	for k, i := range order {
+		sum += weights.At(i)
+		cumulative[k] = sum
+	}
+
+	result := make([]float64, len(qs))
+	for i, q := range qs {
+		k, _ := slices.BinarySearch(cumulative, q*total)
+		// Rounding can leave the final cumulative sum just below total
+		k = min(k, len(order)-1)
+		result[i] = float64(v.At(order[k]))
+	}
+	return &data.Vector[float64]{Element: result}, nil
+}
+
+// WeightedHistogram returns the total weight of the elements falling in each
+// bin, binning them exactly as data.Vector.Histogram does: bin i covers
+// [edges[i], edges[i+1]), except the last bin, which also includes its right
+// edge, and elements outside all bins are ignored. Returns ErrInvalidEdges
+// unless edges are strictly increasing with at least two edges.
+func WeightedHistogram[T data.Number](v *data.Vector[T], weights *data.Vector[float64], edges []float64) (*data.Vector[float64], error) {
+	if _, err := weightTotal(v, weights); err != nil {
+		return nil, err
+	}
+	if err := data.CheckEdges(edges); err != nil {
+		return nil, err
+	}
+
+	counts := make([]float64, len(edges)-1)
+	for i, val := range v.All() {
+		if bin := data.BinIndex(edges, float64(val)); bin >= 0 {
+			counts[bin] += weights.At(i)
+		}
+	}
+	return &data.Vector[float64]{Element: counts}, nil
+}
+
+// weightTotal validates the weights for v and returns their sum
+func weightTotal[T data.Number](v *data.Vector[T], weights *data.Vector[float64]) (float64, error) {
+	if v.Len() != weights.Len() {
+		return 0, ErrMismatchedLengths
+	}
+	if v.Len() == 0 {
+		return 0, errors.New("cannot calculate weighted statistics of empty vector")
+	}
+	var total float64
+	for _, w := range weights.All() {
+		if !(w >= 0) {
+			return 0, ErrNegativeWeight
+		}
+		total += w
+	}
+	if total == 0 {
+		return 0, ErrZeroWeights
+	}
+	return total, nil
+}
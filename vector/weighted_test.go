@@ -0,0 +1,139 @@
+package vector_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wendersoon/gomathx/data"
+	"github.com/wendersoon/gomathx/vector"
+)
+
+func TestWeightedMeanAndVariance(t *testing.T) {
+	v, _ := vector.CreateVector([]int{1, 2, 3})
+	w, _ := vector.CreateVector([]float64{3, 0, 1})
+
+	mean, err := vector.WeightedMean(v, w)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if mean != 1.5 {
+		t.Errorf("expected weighted mean 1.5, got %v", mean)
+	}
+
+	// Integer weights behave like repeating elements: [1 1 1 3]
+	expanded, _ := vector.CreateVector([]int{1, 1, 1, 3})
+	variance, _ := vector.WeightedVariance(v, w)
+	std, _ := vector.WeightedStdDev(v, w)
+	if math.Abs(variance-0.75) > 1e-12 {
+		t.Errorf("expected weighted variance 0.75, got %v", variance)
+	}
+	if math.Abs(std-expanded.StdDev()) > 1e-12 {
+		t.Errorf("expected weighted std %v, got %v", expanded.StdDev(), std)
+	}
+}
+
+func TestWeightedQuantiles(t *testing.T) {
+	v, _ := vector.CreateVector([]float64{4, 1, 3, 2})
+
+	tests := []struct {
+		name     string
+		weights  []float64
+		q        float64
+		expected float64
+	}{
+		{"Equal weights median", []float64{1, 1, 1, 1}, 0.5, 2},
+		{"Heavy element", []float64{10, 1, 1, 1}, 0.5, 4},
+		{"Zero weight skipped", []float64{1, 0, 1, 1}, 0, 2},
+		{"Maximum", []float64{1, 1, 1, 1}, 1, 4},
+		{"Just above a step", []float64{1, 1, 1, 1}, 0.51, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, _ := vector.CreateVector(tt.weights)
+			got, err := vector.WeightedQuantiles(v, w, tt.q)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if got.Element[0] != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got.Element[0])
+			}
+		})
+	}
+
+	w, _ := vector.CreateVector([]float64{1, 1, 1, 1})
+	if median, _ := vector.WeightedMedian(v, w); median != 2 {
+		t.Errorf("expected weighted median 2, got %v", median)
+	}
+	if _, err := vector.WeightedQuantiles(v, w, 1.5); err != data.ErrInvalidQuantile {
+		t.Errorf("expected ErrInvalidQuantile, got: %v", err)
+	}
+}
+
+func TestWeightedHistogram(t *testing.T) {
+	v, _ := vector.CreateVector([]float64{0, 0.5, 1, 1.5, 2, 3})
+	w, _ := vector.CreateVector([]float64{1, 2, 3, 4, 5, 6})
+
+	got, err := vector.WeightedHistogram(v, w, []float64{0, 1, 2})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// [0, 1) holds 0 and 0.5, [1, 2] holds 1, 1.5 and the right edge 2; 3 is outside
+	expected := []float64{3, 12}
+	for i, val := range got.Element {
+		if val != expected[i] {
+			t.Errorf("expected %v in bin %d, got %v", expected[i], i, val)
+		}
+	}
+
+	for _, edges := range [][]float64{{1}, {0, 2, 1}, {0, 0, 1}} {
+		if _, err := vector.WeightedHistogram(v, w, edges); err != vector.ErrInvalidEdges {
+			t.Errorf("edges %v: expected ErrInvalidEdges, got: %v", edges, err)
+		}
+	}
+
+	// Unit weights reproduce the unweighted histogram, including edge values and NaN
+	x, _ := vector.CreateVector([]float64{-1, 0, 0.25, 0.5, 0.75, 1, 1.1, math.NaN()})
+	ones, _ := vector.CreateVector([]float64{1, 1, 1, 1, 1, 1, 1, 1})
+	edges := []float64{0, 0.25, 0.5, 1}
+	weighted, _ := vector.WeightedHistogram(x, ones, edges)
+	plain, _ := x.Histogram(edges)
+	for i, c := range plain.Counts.All() {
+		if weighted.Element[i] != float64(c) {
+			t.Errorf("bin %d: weighted %v, unweighted %d", i, weighted.Element[i], c)
+		}
+	}
+}
+
+func TestWeighted_InvalidWeights(t *testing.T) {
+	v, _ := vector.CreateVector([]int{1, 2, 3})
+
+	tests := []struct {
+		name    string
+		weights []float64
+		wantErr error
+	}{
+		{"Mismatched lengths", []float64{1, 1}, vector.ErrMismatchedLengths},
+		{"Negative weight", []float64{1, -1, 1}, vector.ErrNegativeWeight},
+		{"NaN weight", []float64{1, math.NaN(), 1}, vector.ErrNegativeWeight},
+		{"All zero", []float64{0, 0, 0}, vector.ErrZeroWeights},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, _ := vector.CreateVector(tt.weights)
+			if _, err := vector.WeightedMean(v, w); err != tt.wantErr {
+				t.Errorf("WeightedMean: expected %v, got: %v", tt.wantErr, err)
+			}
+			if _, err := vector.WeightedStdDev(v, w); err != tt.wantErr {
+				t.Errorf("WeightedStdDev: expected %v, got: %v", tt.wantErr, err)
+			}
+			if _, err := vector.WeightedMedian(v, w); err != tt.wantErr {
+				t.Errorf("WeightedMedian: expected %v, got: %v", tt.wantErr, err)
+			}
+			if _, err := vector.WeightedHistogram(v, w, []float64{0, 5}); err != tt.wantErr {
+				t.Errorf("WeightedHistogram: expected %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}